@@ -0,0 +1,71 @@
+package pxl
+
+import "fmt"
+
+// An Alpha1 is a 1-bit color that holds only an alpha channel, either fully opaque or fully transparent.
+// Like the standard library's [image/color.Alpha], it represents white with the given alpha.
+type Alpha1 bool
+
+// Returns the alpha-premultiplied red, green, blue and alpha values
+// for the color. Each value ranges within [0, 0xffff], but is represented
+// by a uint32 so that multiplying by a blend factor up to 0xffff will not
+// overflow.
+//
+// An alpha-premultiplied color component c has been scaled by alpha (a),
+// so has valid values 0 <= c <= a.
+func (c Alpha1) RGBA() (r, g, b, a uint32) {
+	if c {
+		a = 0x0000ffff
+	}
+	return a, a, a, a
+}
+
+// Returns the hexadecimal code representing the RGBA color.
+func (c Alpha1) Hex() string {
+	if c {
+		return "ffffffff"
+	}
+	return "ffffff00"
+}
+
+// An Alpha8 is an 8-bit color that holds only an alpha channel.
+// Like the standard library's [image/color.Alpha], it represents white with the given alpha.
+type Alpha8 uint8
+
+// Returns the alpha-premultiplied red, green, blue and alpha values
+// for the color. Each value ranges within [0, 0xffff], but is represented
+// by a uint32 so that multiplying by a blend factor up to 0xffff will not
+// overflow.
+//
+// An alpha-premultiplied color component c has been scaled by alpha (a),
+// so has valid values 0 <= c <= a.
+func (c Alpha8) RGBA() (r, g, b, a uint32) {
+	a = uint32(c) * 0x00000101
+	return a, a, a, a
+}
+
+// Returns the hexadecimal code representing the RGBA color.
+func (c Alpha8) Hex() string {
+	return fmt.Sprintf("ffffff%02x", uint8(c))
+}
+
+// An Alpha16 is a 16-bit color that holds only an alpha channel.
+// Like the standard library's [image/color.Alpha16], it represents white with the given alpha.
+type Alpha16 uint16
+
+// Returns the alpha-premultiplied red, green, blue and alpha values
+// for the color. Each value ranges within [0, 0xffff], but is represented
+// by a uint32 so that multiplying by a blend factor up to 0xffff will not
+// overflow.
+//
+// An alpha-premultiplied color component c has been scaled by alpha (a),
+// so has valid values 0 <= c <= a.
+func (c Alpha16) RGBA() (r, g, b, a uint32) {
+	a = uint32(c)
+	return a, a, a, a
+}
+
+// Returns the hexadecimal code representing the RGBA color.
+func (c Alpha16) Hex() string {
+	return fmt.Sprintf("ffffffffffff%04x", uint16(c))
+}
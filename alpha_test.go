@@ -0,0 +1,154 @@
+package pxl_test
+
+import (
+	"fmt"
+	"image/color"
+	"pxl"
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAlpha1(t *testing.T) {
+	t.Parallel()
+	t.Run("implements the pxl color interface", func(t *testing.T) {
+		var v any = pxl.Alpha1(false)
+		_, ok := v.(pxl.Color)
+		assert.True(t, ok)
+	})
+	t.Run("does not exceed the expected number of bytes", func(t *testing.T) {
+		assert.Equal(t, 1, int(unsafe.Sizeof(pxl.Alpha1(false))))
+	})
+	t.Run("RGBA()", func(t *testing.T) {
+		r, g, b, a := pxl.Alpha1(true).RGBA()
+		assert.Equal(t, [4]uint32{0xffff, 0xffff, 0xffff, 0xffff}, [4]uint32{r, g, b, a})
+		r, g, b, a = pxl.Alpha1(false).RGBA()
+		assert.Equal(t, [4]uint32{0, 0, 0, 0}, [4]uint32{r, g, b, a})
+	})
+	t.Run("Hex()", func(t *testing.T) {
+		for _, c := range []pxl.Alpha1{false, true} {
+			p, err := pxl.ParseHex[pxl.Alpha1](c.Hex())
+			assert.NoError(t, err)
+			assert.Equal(t, c, p)
+		}
+		assert.Equal(t, "ffffffff", pxl.Alpha1(true).Hex())
+		assert.Equal(t, "ffffff00", pxl.Alpha1(false).Hex())
+		_, err := pxl.ParseHex[pxl.Alpha1]("ffffff80")
+		assert.ErrorIs(t, err, pxl.ErrHexValue)
+	})
+	t.Run("Alpha1Model", func(t *testing.T) {
+		t.Run("converts to the correct value", func(t *testing.T) {
+			testCases := []struct {
+				c color.Color
+				e pxl.Alpha1
+			}{{c: color.Transparent, e: false},
+				{c: color.Black, e: true},
+				{c: color.Alpha{A: 0x7f}, e: false},
+				{c: color.Alpha{A: 0x80}, e: true}}
+			for _, testCase := range testCases {
+				t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+					assert.Equal(t, testCase.e, pxl.Alpha1Model.Convert(testCase.c))
+				})
+			}
+		})
+	})
+}
+
+func TestAlpha8(t *testing.T) {
+	t.Parallel()
+	t.Run("implements the pxl color interface", func(t *testing.T) {
+		var v any = pxl.Alpha8(0x00)
+		_, ok := v.(pxl.Color)
+		assert.True(t, ok)
+	})
+	t.Run("does not exceed the expected number of bytes", func(t *testing.T) {
+		assert.Equal(t, 1, int(unsafe.Sizeof(pxl.Alpha8(0x00))))
+	})
+	t.Run("RGBA()", func(t *testing.T) {
+		t.Run("returns the same values as color.Alpha", func(t *testing.T) {
+			for a := 0; a <= 0xff; a++ {
+				r, g, b, aa := pxl.Alpha8(a).RGBA()
+				er, eg, eb, ea := color.Alpha{A: uint8(a)}.RGBA()
+				assert.Equal(t, [4]uint32{er, eg, eb, ea}, [4]uint32{r, g, b, aa})
+			}
+		})
+	})
+	t.Run("Hex()", func(t *testing.T) {
+		t.Run("returns the correct value", func(t *testing.T) {
+			testCases := []struct {
+				c   pxl.Alpha8
+				hex string
+			}{{c: pxl.Alpha8(0x00), hex: "ffffff00"},
+				{c: pxl.Alpha8(0xff), hex: "ffffffff"},
+				{c: pxl.Alpha8(0x9b), hex: "ffffff9b"}}
+			for _, testCase := range testCases {
+				t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+					assert.Equal(t, testCase.hex, testCase.c.Hex())
+					c, err := pxl.ParseHex[pxl.Alpha8](testCase.hex)
+					assert.NoError(t, err)
+					assert.Equal(t, testCase.c, c)
+				})
+			}
+		})
+		t.Run("is rejected by ParseHex when the color is not white", func(t *testing.T) {
+			_, err := pxl.ParseHex[pxl.Alpha8]("000000ff")
+			assert.ErrorIs(t, err, pxl.ErrHexValue)
+		})
+	})
+	t.Run("Alpha8Model", func(t *testing.T) {
+		t.Run("converts to the correct value", func(t *testing.T) {
+			testCases := []struct {
+				c color.Color
+				e pxl.Alpha8
+			}{{c: color.Transparent, e: 0x00},
+				{c: color.Black, e: 0xff},
+				{c: pxl.RGBA32{R: 0x12, G: 0x34, B: 0x56, A: 0x9b}, e: 0x9b},
+				{c: pxl.Alpha16(0x8080), e: 0x80}}
+			for _, testCase := range testCases {
+				t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+					assert.Equal(t, testCase.e, pxl.Alpha8Model.Convert(testCase.c))
+				})
+			}
+		})
+	})
+}
+
+func TestAlpha16(t *testing.T) {
+	t.Parallel()
+	t.Run("implements the pxl color interface", func(t *testing.T) {
+		var v any = pxl.Alpha16(0x0000)
+		_, ok := v.(pxl.Color)
+		assert.True(t, ok)
+	})
+	t.Run("does not exceed the expected number of bytes", func(t *testing.T) {
+		assert.Equal(t, 2, int(unsafe.Sizeof(pxl.Alpha16(0x0000))))
+	})
+	t.Run("RGBA()", func(t *testing.T) {
+		r, g, b, a := pxl.Alpha16(0x1234).RGBA()
+		assert.Equal(t, [4]uint32{0x1234, 0x1234, 0x1234, 0x1234}, [4]uint32{r, g, b, a})
+	})
+	t.Run("Hex()", func(t *testing.T) {
+		assert.Equal(t, "ffffffffffff1234", pxl.Alpha16(0x1234).Hex())
+		c, err := pxl.ParseHex[pxl.Alpha16]("ffffffffffff1234")
+		assert.NoError(t, err)
+		assert.Equal(t, pxl.Alpha16(0x1234), c)
+	})
+	t.Run("Alpha16Model", func(t *testing.T) {
+		assert.Equal(t, pxl.Alpha16(0x1234), pxl.Alpha16Model.Convert(color.Alpha16{A: 0x1234}))
+		assert.Equal(t, pxl.Alpha16(0x9b9b), pxl.Alpha16Model.Convert(pxl.Alpha8(0x9b)))
+	})
+}
+
+func BenchmarkAlpha8(b *testing.B) {
+	b.Run("RGBA()", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			pxl.Alpha8(i).RGBA()
+		}
+	})
+	b.Run("Hex()", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			pxl.Alpha8(i).Hex()
+		}
+	})
+}
@@ -0,0 +1,224 @@
+package pxl
+
+import (
+	"image"
+	"math"
+	"strconv"
+)
+
+// A BlendMode is a blend mode, as specified by the W3C Compositing and Blending specification,
+// which mixes the colors of a source and a backdrop where they overlap.
+type BlendMode int
+
+// The separable blend modes, which mix each channel independently,
+// and the non-separable blend modes, which mix the hue, saturation and luminosity of the colors.
+const (
+	Normal BlendMode = iota
+	Multiply
+	Screen
+	Overlay
+	Darken
+	Lighten
+	ColorDodge
+	ColorBurn
+	HardLight
+	SoftLight
+	Difference
+	Exclusion
+	Hue
+	Saturation
+	ColorBlend // named to avoid a clash with the Color interface
+	Luminosity
+)
+
+var blendModeNames = [...]string{"Normal", "Multiply", "Screen", "Overlay", "Darken", "Lighten", "ColorDodge", "ColorBurn",
+	"HardLight", "SoftLight", "Difference", "Exclusion", "Hue", "Saturation", "Color", "Luminosity"}
+
+// Returns the name of the blend mode.
+func (m BlendMode) String() string {
+	if m < 0 || int(m) >= len(blendModeNames) {
+		return "BlendMode(" + strconv.Itoa(int(m)) + ")"
+	}
+	return blendModeNames[m]
+}
+
+// Returns the result of blending the source color with the destination color, in the color type of the destination.
+// The blended color is composited over the destination with the source-over operator, after scaling the alpha
+// of the source by opacity, which ranges within [0, 1].
+//
+// Unlike [Composite], the blend modes are computed with float64 channels, whose 53-bit precision exceeds that of
+// [RGBA64] but not that of [RGBA128] and [RGBA256]: their low bits are rounded away, even for [Normal] with partial
+// opacity. Only [Normal] at full opacity, which is source-over compositing, and transparent sources, which leave
+// the destination untouched, preserve every bit of the destination.
+func BlendColor[T Color](dst T, src Color, mode BlendMode, opacity float64) T {
+	return fromWide[T](mode.blend(wideOf(dst), wideOf(src), opacity))
+}
+
+// Blends the source image into the destination image, in place, where their bounds overlap.
+// Each pixel is blended as by [BlendColor], with its precision limits.
+func Blend[T Color](dst Image[T], src image.Image, mode BlendMode, opacity float64) {
+	r := dst.Bounds().Intersect(src.Bounds())
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		for x := r.Min.X; x < r.Max.X; x++ {
+			dst.Set(x, y, fromWide[T](mode.blend(wideOf(dst.Get(x, y)), wideOf(src.At(x, y)), opacity)))
+		}
+	}
+}
+
+// Blends the source image into the destination image through a mask, in place.
+// It behaves like [Blend], but the opacity at each pixel is scaled by the alpha of the mask.
+// A nil mask is fully opaque.
+func BlendMask[T Color](dst Image[T], src image.Image, mask image.Image, mode BlendMode, opacity float64) {
+	r := dst.Bounds().Intersect(src.Bounds())
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		for x := r.Min.X; x < r.Max.X; x++ {
+			o := opacity * float64(maskAt(mask, x, y)) / wideMax
+			dst.Set(x, y, fromWide[T](mode.blend(wideOf(dst.Get(x, y)), wideOf(src.At(x, y)), o)))
+		}
+	}
+}
+
+// Returns the result of blending the source color with the backdrop color,
+// and compositing it over the backdrop with the source-over operator.
+func (m BlendMode) blend(b, s wide, opacity float64) wide {
+	// A transparent source leaves the backdrop exactly as it is, including its channels when it is transparent too.
+	// A NaN opacity is treated as zero, as wideChannel treats NaN channels.
+	if s.a == 0 || !(opacity > 0) {
+		return b
+	}
+	if m == Normal && opacity >= 1 {
+		return SrcOver.composite(b, s)
+	}
+	br, bg, bb, ba := b.floats()
+	sr, sg, sb, sa := s.floats()
+	sa *= min(opacity, 1)
+	if sa == 0 {
+		return b
+	}
+	a := sa + ba*(1-sa)
+	if a == 0 {
+		return wide{}
+	}
+	mr, mg, mb := m.mix([3]float64{br, bg, bb}, [3]float64{sr, sg, sb})
+	// Where the backdrop is translucent, the source shows through unblended.
+	mr = (1-ba)*sr + ba*mr
+	mg = (1-ba)*sg + ba*mg
+	mb = (1-ba)*sb + ba*mb
+	return wideFromFloats(
+		(sa*mr+ba*(1-sa)*br)/a,
+		(sa*mg+ba*(1-sa)*bg)/a,
+		(sa*mb+ba*(1-sa)*bb)/a,
+		a,
+	)
+}
+
+// Returns the mix of the source color with the backdrop color.
+func (m BlendMode) mix(b, s [3]float64) (r, g, bb float64) {
+	switch m {
+	case Hue:
+		return setLum(setSat(s, sat(b)), lum(b))
+	case Saturation:
+		return setLum(setSat(b, sat(s)), lum(b))
+	case ColorBlend:
+		return setLum(s, lum(b))
+	case Luminosity:
+		return setLum(b, lum(s))
+	}
+	return m.mixChannel(b[0], s[0]), m.mixChannel(b[1], s[1]), m.mixChannel(b[2], s[2])
+}
+
+// Returns the mix of a source channel with a backdrop channel, for the separable blend modes.
+func (m BlendMode) mixChannel(b, s float64) float64 {
+	switch m {
+	case Multiply:
+		return b * s
+	case Screen:
+		return b + s - b*s
+	case Overlay:
+		return HardLight.mixChannel(s, b)
+	case Darken:
+		return min(b, s)
+	case Lighten:
+		return max(b, s)
+	case ColorDodge:
+		switch {
+		case b == 0:
+			return 0
+		case s == 1:
+			return 1
+		}
+		return min(1, b/(1-s))
+	case ColorBurn:
+		switch {
+		case b == 1:
+			return 1
+		case s == 0:
+			return 0
+		}
+		return 1 - min(1, (1-b)/s)
+	case HardLight:
+		if s <= 0.5 {
+			return Multiply.mixChannel(b, 2*s)
+		}
+		return Screen.mixChannel(b, 2*s-1)
+	case SoftLight:
+		if s <= 0.5 {
+			return b - (1-2*s)*b*(1-b)
+		}
+		d := math.Sqrt(b)
+		if b <= 0.25 {
+			d = ((16*b-12)*b + 4) * b
+		}
+		return b + (2*s-1)*(d-b)
+	case Difference:
+		return math.Abs(b - s)
+	case Exclusion:
+		return b + s - 2*b*s
+	}
+	return s
+}
+
+// Returns the luminosity of the color, as defined by the non-separable blend modes.
+func lum(c [3]float64) float64 {
+	return 0.3*c[0] + 0.59*c[1] + 0.11*c[2]
+}
+
+// Returns the color with its luminosity set to l, clipping it into gamut while preserving its luminosity.
+func setLum(c [3]float64, l float64) (r, g, b float64) {
+	d := l - lum(c)
+	r, g, b = c[0]+d, c[1]+d, c[2]+d
+	l = lum([3]float64{r, g, b})
+	lo, hi := min(r, g, b), max(r, g, b)
+	if lo < 0 {
+		r, g, b = l+(r-l)*l/(l-lo), l+(g-l)*l/(l-lo), l+(b-l)*l/(l-lo)
+	}
+	if hi > 1 {
+		r, g, b = l+(r-l)*(1-l)/(hi-l), l+(g-l)*(1-l)/(hi-l), l+(b-l)*(1-l)/(hi-l)
+	}
+	return
+}
+
+// Returns the saturation of the color, as defined by the non-separable blend modes.
+func sat(c [3]float64) float64 {
+	return max(c[0], c[1], c[2]) - min(c[0], c[1], c[2])
+}
+
+// Returns the color with its saturation set to s, keeping the order of its channels.
+func setSat(c [3]float64, s float64) [3]float64 {
+	lo, mid, hi := 0, 1, 2
+	if c[lo] > c[mid] {
+		lo, mid = mid, lo
+	}
+	if c[mid] > c[hi] {
+		mid, hi = hi, mid
+	}
+	if c[lo] > c[mid] {
+		lo, mid = mid, lo
+	}
+	var r [3]float64
+	if c[hi] > c[lo] {
+		r[mid] = (c[mid] - c[lo]) * s / (c[hi] - c[lo])
+		r[hi] = s
+	}
+	return r
+}
@@ -0,0 +1,121 @@
+package pxl_test
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+	"pxl"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlendMode(t *testing.T) {
+	t.Parallel()
+	t.Run("String()", func(t *testing.T) {
+		assert.Equal(t, "Normal", pxl.Normal.String())
+		assert.Equal(t, "SoftLight", pxl.SoftLight.String())
+		assert.Equal(t, "Color", pxl.ColorBlend.String())
+		assert.Equal(t, "BlendMode(16)", pxl.BlendMode(16).String())
+	})
+}
+
+func TestBlendColor(t *testing.T) {
+	t.Parallel()
+	t.Run("returns the correct value", func(t *testing.T) {
+		b := pxl.RGBA32{R: 0xcc, G: 0x33, B: 0x66, A: 0xff}
+		s := pxl.RGBA32{R: 0x33, G: 0x99, B: 0xff, A: 0xff}
+		testCases := []struct {
+			mode pxl.BlendMode
+			e    pxl.RGBA32
+		}{{mode: pxl.Normal, e: s},
+			{mode: pxl.Multiply, e: pxl.RGBA32{R: 0x29, G: 0x1f, B: 0x66, A: 0xff}},
+			{mode: pxl.Screen, e: pxl.RGBA32{R: 0xd6, G: 0xad, B: 0xff, A: 0xff}},
+			{mode: pxl.Overlay, e: pxl.RGBA32{R: 0xad, G: 0x3d, B: 0xcc, A: 0xff}},
+			{mode: pxl.Darken, e: pxl.RGBA32{R: 0x33, G: 0x33, B: 0x66, A: 0xff}},
+			{mode: pxl.Lighten, e: pxl.RGBA32{R: 0xcc, G: 0x99, B: 0xff, A: 0xff}},
+			{mode: pxl.ColorDodge, e: pxl.RGBA32{R: 0xff, G: 0x80, B: 0xff, A: 0xff}},
+			{mode: pxl.ColorBurn, e: pxl.RGBA32{R: 0x00, G: 0x00, B: 0x66, A: 0xff}},
+			{mode: pxl.HardLight, e: pxl.RGBA32{R: 0x52, G: 0x5c, B: 0xff, A: 0xff}},
+			{mode: pxl.SoftLight, e: pxl.RGBA32{R: 0xb4, G: 0x40, B: 0xa1, A: 0xff}},
+			{mode: pxl.Difference, e: pxl.RGBA32{R: 0x99, G: 0x66, B: 0x99, A: 0xff}},
+			{mode: pxl.Exclusion, e: pxl.RGBA32{R: 0xad, G: 0x8f, B: 0x99, A: 0xff}},
+			{mode: pxl.Hue, e: pxl.RGBA32{R: 0x29, G: 0x75, B: 0xc2, A: 0xff}},
+			{mode: pxl.Saturation, e: pxl.RGBA32{R: 0xee, G: 0x22, B: 0x66, A: 0xff}},
+			{mode: pxl.ColorBlend, e: pxl.RGBA32{R: 0x14, G: 0x7a, B: 0xe0, A: 0xff}},
+			{mode: pxl.Luminosity, e: pxl.RGBA32{R: 0xeb, G: 0x52, B: 0x85, A: 0xff}}}
+		for _, testCase := range testCases {
+			t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+				assert.Equal(t, testCase.e, pxl.BlendColor(b, s, testCase.mode, 1))
+			})
+		}
+	})
+	t.Run("scales the source by opacity", func(t *testing.T) {
+		b := pxl.RGBA32{R: 0xcc, G: 0x33, B: 0x66, A: 0xff}
+		s := pxl.RGBA32{R: 0x33, G: 0x99, B: 0xff, A: 0xff}
+		assert.Equal(t, pxl.RGBA32{R: 0x7a, G: 0x29, B: 0x66, A: 0xff}, pxl.BlendColor(b, s, pxl.Multiply, 0.5))
+		assert.Equal(t, b, pxl.BlendColor(b, s, pxl.Multiply, 0))
+		assert.Equal(t, b, pxl.BlendColor(b, s, pxl.Multiply, -1))
+		assert.Equal(t, b, pxl.BlendColor(b, s, pxl.Multiply, math.NaN()))
+		assert.Equal(t, b, pxl.BlendColor(b, s, pxl.Normal, math.NaN()))
+	})
+	t.Run("leaves the destination untouched under transparent sources", func(t *testing.T) {
+		b := pxl.RGBA32{R: 0xcc, G: 0x33, B: 0x66, A: 0x00}
+		wb := pxl.RGBA256{R: 0x0123456789abcdef, G: 0xfedcba9876543210, B: 0x0f0f0f0f0f0f0f0f, A: 0x8000000000000001}
+		s := pxl.RGBA32{R: 0x33, G: 0x99, B: 0xff, A: 0xff}
+		for _, m := range []pxl.BlendMode{pxl.Normal, pxl.Multiply, pxl.Screen, pxl.Difference, pxl.Luminosity} {
+			t.Run(m.String(), func(t *testing.T) {
+				assert.Equal(t, b, pxl.BlendColor(b, pxl.RGBA32{R: 0x33, G: 0x99, B: 0xff, A: 0x00}, m, 1))
+				assert.Equal(t, b, pxl.BlendColor(b, s, m, 0))
+				assert.Equal(t, wb, pxl.BlendColor(wb, pxl.RGBA32{}, m, 1))
+				assert.Equal(t, wb, pxl.BlendColor(wb, s, m, 0))
+			})
+		}
+	})
+	t.Run("handles translucent colors", func(t *testing.T) {
+		s := pxl.RGBA32{R: 0x33, G: 0x99, B: 0xff, A: 0xff}
+		assert.Equal(t, s, pxl.BlendColor(pxl.RGBA32{R: 0xcc, G: 0x33, B: 0x66, A: 0x00}, s, pxl.Multiply, 1))
+		e := pxl.RGBA32{R: 0x62, G: 0x4e, B: 0x99, A: 0xc0}
+		assert.Equal(t, e, pxl.BlendColor(pxl.RGBA32{R: 0xcc, G: 0x33, B: 0x66, A: 0x80}, pxl.RGBA32{R: 0x33, G: 0x99, B: 0xff, A: 0x80}, pxl.Multiply, 1))
+	})
+}
+
+func TestBlend(t *testing.T) {
+	t.Parallel()
+	t.Run("blends the overlapping pixels", func(t *testing.T) {
+		dst := pxl.NewImage[pxl.RGBA32](image.Rect(0, 0, 2, 2))
+		for i := range dst.Pix {
+			dst.Pix[i] = pxl.RGBA32{R: 0xcc, G: 0x33, B: 0x66, A: 0xff}
+		}
+		src := image.NewNRGBA(image.Rect(1, 0, 3, 2))
+		for y := 0; y < 2; y++ {
+			for x := 1; x < 3; x++ {
+				src.Set(x, y, color.NRGBA{R: 0x33, G: 0x99, B: 0xff, A: 0xff})
+			}
+		}
+		pxl.Blend[pxl.RGBA32](dst, src, pxl.Multiply, 1)
+		assert.Equal(t, []pxl.RGBA32{
+			{R: 0xcc, G: 0x33, B: 0x66, A: 0xff}, {R: 0x29, G: 0x1f, B: 0x66, A: 0xff},
+			{R: 0xcc, G: 0x33, B: 0x66, A: 0xff}, {R: 0x29, G: 0x1f, B: 0x66, A: 0xff},
+		}, dst.Pix)
+	})
+}
+
+func BenchmarkBlend(b *testing.B) {
+	dst := pxl.RGBA32{R: 0xcc, G: 0x33, B: 0x66, A: 0xff}
+	src := pxl.RGBA32{R: 0x33, G: 0x99, B: 0xff, A: 0x80}
+	b.Run("BlendColor()", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			pxl.BlendColor(dst, src, pxl.SoftLight, 0.8)
+		}
+	})
+	b.Run("Blend()", func(b *testing.B) {
+		d := pxl.NewImage[pxl.RGBA32](image.Rect(0, 0, 256, 256))
+		s := pxl.NewImage[pxl.RGBA32](image.Rect(0, 0, 256, 256))
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			pxl.Blend[pxl.RGBA32](d, s, pxl.Multiply, 1)
+		}
+	})
+}
@@ -0,0 +1,187 @@
+package pxl
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"unsafe"
+)
+
+// A CMYK32 is a 32-bit opaque color represented by the subtractive CMYK color model.
+// Each ink is represented by 8 bits.
+// CMYK32 is equivalent to the standard library's [image/color.CMYK].
+type CMYK32 struct {
+	C, M, Y, K uint8
+}
+
+// Returns the alpha-premultiplied red, green, blue and alpha values
+// for the color. Each value ranges within [0, 0xffff], but is represented
+// by a uint32 so that multiplying by a blend factor up to 0xffff will not
+// overflow.
+//
+// An alpha-premultiplied color component c has been scaled by alpha (a),
+// so has valid values 0 <= c <= a.
+func (c CMYK32) RGBA() (r, g, b, a uint32) {
+	return wideOf(c).rgba()
+}
+
+// Returns the hexadecimal code representing the CMYK color, in the order cyan, magenta, yellow, black.
+func (c CMYK32) Hex() string {
+	return fmt.Sprintf("%02x%02x%02x%02x", c.C, c.M, c.Y, c.K)
+}
+
+// A CMYK64 is a 64-bit opaque color represented by the subtractive CMYK color model.
+// Each ink is represented by 16 bits.
+type CMYK64 struct {
+	C, M, Y, K uint16
+}
+
+// Returns the alpha-premultiplied red, green, blue and alpha values
+// for the color. Each value ranges within [0, 0xffff], but is represented
+// by a uint32 so that multiplying by a blend factor up to 0xffff will not
+// overflow.
+//
+// An alpha-premultiplied color component c has been scaled by alpha (a),
+// so has valid values 0 <= c <= a.
+func (c CMYK64) RGBA() (r, g, b, a uint32) {
+	return wideOf(c).rgba()
+}
+
+// Returns the hexadecimal code representing the CMYK color, in the order cyan, magenta, yellow, black.
+func (c CMYK64) Hex() string {
+	return fmt.Sprintf("%04x%04x%04x%04x", c.C, c.M, c.Y, c.K)
+}
+
+// A CMYK is a color of this package represented by the CMYK color model.
+type CMYK interface {
+	Color
+	CMYK32 | CMYK64
+}
+
+// Returns the inks of the color, each within [0, 0xffffffffffffffff], if it is a CMYK color.
+func inksOf(c color.Color) (cc, m, y, k uint64, ok bool) {
+	switch c := c.(type) {
+	case CMYK32:
+		return expand(uint64(c.C), 0xff), expand(uint64(c.M), 0xff), expand(uint64(c.Y), 0xff), expand(uint64(c.K), 0xff), true
+	case CMYK64:
+		return expand(uint64(c.C), 0xffff), expand(uint64(c.M), 0xffff), expand(uint64(c.Y), 0xffff), expand(uint64(c.K), 0xffff), true
+	case color.CMYK:
+		return expand(uint64(c.C), 0xff), expand(uint64(c.M), 0xff), expand(uint64(c.Y), 0xff), expand(uint64(c.K), 0xff), true
+	}
+	return 0, 0, 0, 0, false
+}
+
+// Returns the color of type T printed with the given inks, if T is a CMYK color.
+// Converting between CMYK colors keeps their inks, rather than separating them again.
+func fromInks[T Color](cc, m, y, k uint64) (T, bool) {
+	var res T
+	switch p := any(&res).(type) {
+	case *CMYK32:
+		*p = CMYK32{C: uint8(quantize(cc, 0xff)), M: uint8(quantize(m, 0xff)), Y: uint8(quantize(y, 0xff)), K: uint8(quantize(k, 0xff))}
+	case *CMYK64:
+		*p = CMYK64{C: uint16(quantize(cc, 0xffff)), M: uint16(quantize(m, 0xffff)), Y: uint16(quantize(y, 0xffff)), K: uint16(quantize(k, 0xffff))}
+	default:
+		return res, false
+	}
+	return res, true
+}
+
+// A Separation describes how RGB colors are separated into CMYK inks.
+//
+// The gray component of a color, the amount of cyan, magenta and yellow that it has in common,
+// can be printed with black ink instead. Black replaces the given fraction of the gray component
+// (gray component replacement, or GCR). A Black of 1 is the naive conversion of [Convert] and of
+// the standard library, while a Black of 0 uses no black ink at all. Either way, the separated
+// color converts back to the same RGB color, up to rounding, as long as the ink limit is not reached.
+//
+// MaxInk limits the total ink coverage, as the sum of the four inks within [0, 4], by removing
+// cyan, magenta and yellow under the black (under color removal, or UCR). Presses commonly
+// require a limit between 2.4 and 3.2. A MaxInk of 0 is unlimited. Colors whose inks exceed the limit
+// are lightened and desaturated by it, so that they no longer convert back to the same RGB color.
+type Separation struct {
+	Black  float64
+	MaxInk float64
+}
+
+// Common separations.
+var (
+	// NaiveSeparation replaces the whole gray component with black, as [Convert] does.
+	NaiveSeparation = Separation{Black: 1}
+	// GCRSeparation replaces most of the gray component with black, and limits the total ink to 300%.
+	GCRSeparation = Separation{Black: 0.8, MaxInk: 3}
+	// UCRSeparation replaces a small part of the gray component with black, so that colors are mostly printed
+	// with cyan, magenta and yellow, and limits the total ink to 300%. Under color removal thus only applies
+	// to the darkest colors, whose ink would exceed the limit.
+	UCRSeparation = Separation{Black: 0.3, MaxInk: 3}
+)
+
+// Returns the color separated into CMYK inks.
+func Separate[T CMYK](c Color, s Separation) T {
+	r, g, b, a := wideOf(c).floats()
+	cc, m, y := 1-r*a, 1-g*a, 1-b*a
+	k := min(cc, m, y) * min(max(s.Black, 0), 1)
+	if k < 1 {
+		cc, m, y = (cc-k)/(1-k), (m-k)/(1-k), (y-k)/(1-k)
+	} else {
+		cc, m, y = 0, 0, 0
+	}
+	if s.MaxInk > 0 {
+		if total := cc + m + y + k; total > s.MaxInk {
+			f := max(s.MaxInk-k, 0) / (cc + m + y)
+			cc, m, y = cc*f, m*f, y*f
+		}
+	}
+	res, _ := fromInks[T](wideChannel(cc), wideChannel(m), wideChannel(y), wideChannel(k))
+	return res
+}
+
+// An Ink is a named spot color, such as a Pantone ink, printed on its own plate.
+// Its appearance is approximated by an alternate CMYK color, used for proofing and
+// for separating the ink into process colors.
+type Ink struct {
+	Name      string
+	Alternate CMYK64
+}
+
+// Returns the process color approximating the ink printed at the given tint, within [0, 1].
+func (i Ink) Tint(t float64) CMYK64 {
+	f := wideChannel(t)
+	tint := func(v uint16) uint16 {
+		return uint16(quantize(mulDiv(expand(uint64(v), 0xffff), f, wideMax), 0xffff))
+	}
+	return CMYK64{C: tint(i.Alternate.C), M: tint(i.Alternate.M), Y: tint(i.Alternate.Y), K: tint(i.Alternate.K)}
+}
+
+// Returns the image as a standard library [image.CMYK], for encoders that write CMYK pixels.
+// Neither the standard library nor golang.org/x/image provides one: image/jpeg converts images to YCbCr
+// and golang.org/x/image/tiff writes RGB, so only an external CMYK encoder preserves the inks.
+// A [Grid] shares its pixels with the returned image; any other image is copied.
+func ToCMYK(img Image[CMYK32]) *image.CMYK {
+	if g, ok := img.(*Grid[CMYK32]); ok {
+		var pix []uint8
+		if len(g.Pix) > 0 {
+			pix = unsafe.Slice(&g.Pix[0].C, 4*len(g.Pix))
+		}
+		return &image.CMYK{Pix: pix, Stride: 4 * g.Stride, Rect: g.Rect}
+	}
+	r := img.Bounds()
+	dst := image.NewCMYK(r)
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		for x := r.Min.X; x < r.Max.X; x++ {
+			c := img.Get(x, y)
+			i := dst.PixOffset(x, y)
+			dst.Pix[i+0], dst.Pix[i+1], dst.Pix[i+2], dst.Pix[i+3] = c.C, c.M, c.Y, c.K
+		}
+	}
+	return dst
+}
+
+// Returns the standard library [image.CMYK], such as a decoded CMYK JPEG, as a [Grid] of CMYK32 colors
+// that shares its pixels.
+func FromCMYK(img *image.CMYK) *Grid[CMYK32] {
+	var pix []CMYK32
+	if len(img.Pix) >= 4 {
+		pix = unsafe.Slice((*CMYK32)(unsafe.Pointer(&img.Pix[0])), len(img.Pix)/4)
+	}
+	return &Grid[CMYK32]{Pix: pix, Stride: img.Stride / 4, Rect: img.Rect}
+}
@@ -0,0 +1,206 @@
+package pxl_test
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"pxl"
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCMYK32(t *testing.T) {
+	t.Parallel()
+	t.Run("implements the pxl color interface", func(t *testing.T) {
+		var v any = pxl.CMYK32{}
+		_, ok := v.(pxl.Color)
+		assert.True(t, ok)
+	})
+	t.Run("does not exceed the expected number of bytes", func(t *testing.T) {
+		assert.Equal(t, 4, int(unsafe.Sizeof(pxl.CMYK32{})))
+	})
+	t.Run("RGBA()", func(t *testing.T) {
+		t.Run("matches the standard library up to rounding", func(t *testing.T) {
+			testCases := []pxl.CMYK32{{},
+				{K: 0xff},
+				{C: 0xff},
+				{C: 0x12, M: 0x9b, Y: 0x40, K: 0x33},
+				{C: 0xff, M: 0xff, Y: 0xff, K: 0xff}}
+			for _, testCase := range testCases {
+				t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+					r, g, b, a := testCase.RGBA()
+					er, eg, eb, ea := color.CMYK(testCase).RGBA()
+					assert.InDelta(t, er, r, 1)
+					assert.InDelta(t, eg, g, 1)
+					assert.InDelta(t, eb, b, 1)
+					assert.Equal(t, ea, a)
+				})
+			}
+		})
+	})
+	t.Run("Hex()", func(t *testing.T) {
+		assert.Equal(t, "129b4033", pxl.CMYK32{C: 0x12, M: 0x9b, Y: 0x40, K: 0x33}.Hex())
+	})
+	t.Run("Convert()", func(t *testing.T) {
+		t.Run("matches the standard library up to rounding", func(t *testing.T) {
+			testCases := []pxl.RGBA32{{A: 0xff},
+				{R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+				{R: 0xff, A: 0xff},
+				{R: 0x66, G: 0x33, B: 0x99, A: 0xff},
+				{R: 0xaa, G: 0x55, B: 0x11, A: 0xff}}
+			for _, testCase := range testCases {
+				t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+					c := pxl.Convert[pxl.CMYK32](testCase)
+					e := color.CMYKModel.Convert(color.NRGBA(testCase)).(color.CMYK)
+					assert.InDelta(t, e.C, c.C, 1)
+					assert.InDelta(t, e.M, c.M, 1)
+					assert.InDelta(t, e.Y, c.Y, 1)
+					assert.InDelta(t, e.K, c.K, 1)
+				})
+			}
+		})
+		t.Run("round-trips opaque colors", func(t *testing.T) {
+			c := pxl.RGBA32{R: 0xaa, G: 0x55, B: 0x11, A: 0xff}
+			assert.Equal(t, c, pxl.Convert[pxl.RGBA32](pxl.Convert[pxl.CMYK32](c)))
+		})
+	})
+}
+
+func TestCMYK64(t *testing.T) {
+	t.Parallel()
+	t.Run("does not exceed the expected number of bytes", func(t *testing.T) {
+		assert.Equal(t, 8, int(unsafe.Sizeof(pxl.CMYK64{})))
+	})
+	t.Run("Hex()", func(t *testing.T) {
+		assert.Equal(t, "12349b9b4000ffff", pxl.CMYK64{C: 0x1234, M: 0x9b9b, Y: 0x4000, K: 0xffff}.Hex())
+	})
+	t.Run("ParseHex() parses the codes produced by Hex()", func(t *testing.T) {
+		c := pxl.CMYK64{C: 0x1234, M: 0x9b9b, Y: 0x4000, K: 0xffff}
+		p, err := pxl.ParseHex[pxl.CMYK64](c.Hex())
+		assert.NoError(t, err)
+		assert.Equal(t, c, p)
+	})
+	t.Run("converts to and from CMYK32 without loss", func(t *testing.T) {
+		c := pxl.CMYK32{C: 0x12, M: 0x9b, Y: 0x40, K: 0x33}
+		w := pxl.Convert[pxl.CMYK64](c)
+		assert.Equal(t, pxl.CMYK64{C: 0x1212, M: 0x9b9b, Y: 0x4040, K: 0x3333}, w)
+		assert.Equal(t, c, pxl.Convert[pxl.CMYK32](w))
+	})
+	t.Run("is the color model of CMYK64 images", func(t *testing.T) {
+		assert.Equal(t, pxl.CMYK64Model, pxl.NewImage[pxl.CMYK64](image.Rect(0, 0, 1, 1)).ColorModel())
+	})
+}
+
+func TestSeparate(t *testing.T) {
+	t.Parallel()
+	t.Run("matches Convert() for the naive separation", func(t *testing.T) {
+		testCases := []pxl.RGBA32{{A: 0xff},
+			{R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+			{R: 0x66, G: 0x33, B: 0x99, A: 0xff},
+			{R: 0xaa, G: 0x55, B: 0x11, A: 0xff}}
+		for _, testCase := range testCases {
+			t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+				assert.Equal(t, pxl.Convert[pxl.CMYK32](testCase), pxl.Separate[pxl.CMYK32](testCase, pxl.NaiveSeparation))
+			})
+		}
+	})
+	t.Run("returns separations of the same color", func(t *testing.T) {
+		testCases := []struct {
+			s pxl.Separation
+			e pxl.CMYK32
+		}{{s: pxl.NaiveSeparation, e: pxl.CMYK32{C: 0x00, M: 0x80, Y: 0xe6, K: 0x55}},
+			{s: pxl.Separation{}, e: pxl.CMYK32{C: 0x55, M: 0xaa, Y: 0xee, K: 0x00}},
+			{s: pxl.Separation{Black: 0.5}, e: pxl.CMYK32{C: 0x33, M: 0x99, Y: 0xeb, K: 0x2b}}}
+		c := pxl.RGBA32{R: 0xaa, G: 0x55, B: 0x11, A: 0xff}
+		for _, testCase := range testCases {
+			t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+				s := pxl.Separate[pxl.CMYK32](c, testCase.s)
+				assert.Equal(t, testCase.e, s)
+				assert.InDelta(t, 0, pxl.DeltaE2000(c, s), 0.5)
+			})
+		}
+	})
+	t.Run("limits the total ink", func(t *testing.T) {
+		c := pxl.RGBA64{R: 0x0800, G: 0x0400, B: 0x0200, A: 0xffff}
+		testCases := []pxl.Separation{pxl.GCRSeparation, pxl.UCRSeparation, {Black: 0, MaxInk: 2.4}}
+		for _, testCase := range testCases {
+			t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+				s := pxl.Separate[pxl.CMYK64](c, testCase)
+				total := (float64(s.C) + float64(s.M) + float64(s.Y) + float64(s.K)) / 0xffff
+				assert.LessOrEqual(t, total, testCase.MaxInk+0.0001)
+			})
+		}
+	})
+	t.Run("round-trips colors only below the ink limit", func(t *testing.T) {
+		testCases := []struct {
+			c pxl.RGBA64
+			s pxl.Separation
+			e bool
+		}{{c: pxl.RGBA64{R: 0xaaaa, G: 0x5555, B: 0x1111, A: 0xffff}, s: pxl.GCRSeparation, e: true},
+			{c: pxl.RGBA64{R: 0xaaaa, G: 0x5555, B: 0x1111, A: 0xffff}, s: pxl.UCRSeparation, e: true},
+			{c: pxl.RGBA64{R: 0x0800, G: 0x0400, B: 0x0200, A: 0xffff}, s: pxl.Separation{Black: 0.3}, e: true},
+			{c: pxl.RGBA64{R: 0x0800, G: 0x0400, B: 0x0200, A: 0xffff}, s: pxl.UCRSeparation, e: false},
+			{c: pxl.RGBA64{R: 0x0800, G: 0x0400, B: 0x0200, A: 0xffff}, s: pxl.Separation{MaxInk: 2.4}, e: false}}
+		for _, testCase := range testCases {
+			t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+				s := pxl.Separate[pxl.CMYK64](testCase.c, testCase.s)
+				assert.Equal(t, testCase.e, pxl.DeltaE2000(testCase.c, s) < 0.5)
+			})
+		}
+	})
+}
+
+func TestInk(t *testing.T) {
+	t.Parallel()
+	ink := pxl.Ink{Name: "Reflex Blue", Alternate: pxl.CMYK64{C: 0xffff, M: 0xb333, K: 0x0ccc}}
+	t.Run("Tint() scales the alternate color", func(t *testing.T) {
+		testCases := []struct {
+			t float64
+			e pxl.CMYK64
+		}{{t: 0, e: pxl.CMYK64{}},
+			{t: 1, e: ink.Alternate},
+			{t: 0.5, e: pxl.CMYK64{C: 0x8000, M: 0x599a, K: 0x0666}},
+			{t: 2, e: ink.Alternate}}
+		for _, testCase := range testCases {
+			t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+				assert.Equal(t, testCase.e, ink.Tint(testCase.t))
+			})
+		}
+	})
+}
+
+func TestCMYKImages(t *testing.T) {
+	t.Parallel()
+	r := image.Rect(1, 2, 4, 5)
+	c := pxl.CMYK32{C: 0x12, M: 0x9b, Y: 0x40, K: 0x33}
+	t.Run("ToCMYK() shares the pixels of a grid", func(t *testing.T) {
+		g := pxl.NewImage[pxl.CMYK32](r)
+		g.Set(2, 3, c)
+		img := pxl.ToCMYK(g)
+		assert.Equal(t, r, img.Bounds())
+		assert.Equal(t, color.CMYK(c), img.CMYKAt(2, 3))
+		img.SetCMYK(3, 4, color.CMYK{K: 0xff})
+		assert.Equal(t, pxl.CMYK32{K: 0xff}, g.Get(3, 4))
+	})
+	t.Run("ToCMYK() copies other images", func(t *testing.T) {
+		g := pxl.NewImage[pxl.CMYK32](r)
+		g.Set(2, 3, c)
+		// The embedding hides the Grid from ToCMYK, as any other implementation of Image[CMYK32] would be.
+		img := pxl.ToCMYK(struct{ *pxl.Grid[pxl.CMYK32] }{g})
+		assert.Equal(t, r, img.Bounds())
+		assert.Equal(t, color.CMYK(c), img.CMYKAt(2, 3))
+		img.SetCMYK(3, 4, color.CMYK{K: 0xff})
+		assert.Equal(t, pxl.CMYK32{}, g.Get(3, 4))
+	})
+	t.Run("FromCMYK() shares the pixels of the image", func(t *testing.T) {
+		img := image.NewCMYK(r)
+		img.SetCMYK(2, 3, color.CMYK(c))
+		g := pxl.FromCMYK(img)
+		assert.Equal(t, r, g.Bounds())
+		assert.Equal(t, c, g.Get(2, 3))
+		g.Set(3, 4, c)
+		assert.Equal(t, color.CMYK(c), img.CMYKAt(3, 4))
+	})
+}
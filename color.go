@@ -4,6 +4,8 @@ import "image/color"
 
 // A Color is a color represented by a specific color model.
 // It satisfies and extends the standard library's [image/color.Color] interface.
+// Unless stated otherwise, the channels of a color are encoded with the [SRGB] transfer function,
+// as are those returned by RGBA().
 type Color interface {
 	color.Color
 	// Returns the hexadecimal code representing the RGBA color.
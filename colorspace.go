@@ -0,0 +1,206 @@
+package pxl
+
+import "math"
+
+// A Chromaticity is a color, regardless of its luminance, represented by its CIE 1931 x and y coordinates.
+type Chromaticity struct {
+	X, Y float64
+}
+
+// Standard illuminants, used as white points.
+var (
+	// D50 is the white point of print, of ICC profile connection spaces and of ProPhoto RGB.
+	D50 = Chromaticity{X: 0.3457, Y: 0.3585}
+	// D65 is the white point of sRGB and of most displays.
+	D65 = Chromaticity{X: 0.3127, Y: 0.3290}
+)
+
+// Returns the CIE XYZ tristimulus values of the chromaticity, scaled to a luminance of 1.
+func (c Chromaticity) xyz() (x, y, z float64) {
+	return c.X / c.Y, 1, (1 - c.X - c.Y) / c.Y
+}
+
+// A ColorSpace is an RGB color space, described by the chromaticities of its primaries and of its white point,
+// and by the transfer function that encodes its channels.
+//
+// The colors of this package are in the sRGB color space. Colors in another color space,
+// such as those of images tagged with a Display P3 profile, share the same types but must be converted
+// with [ConvertSpace] or [ConvertImageSpace] before being displayed or mixed with sRGB colors.
+// See [InGamut] to test whether a color lies within the gamut of a color space.
+type ColorSpace struct {
+	Name             string
+	Red, Green, Blue Chromaticity
+	White            Chromaticity
+	Transfer         *TransferFunction
+}
+
+// Common color spaces.
+var (
+	// SRGBSpace is the sRGB color space (IEC 61966-2-1) of the web and of most displays.
+	SRGBSpace = &ColorSpace{
+		Name:     "sRGB",
+		Red:      Chromaticity{X: 0.64, Y: 0.33},
+		Green:    Chromaticity{X: 0.30, Y: 0.60},
+		Blue:     Chromaticity{X: 0.15, Y: 0.06},
+		White:    D65,
+		Transfer: SRGB,
+	}
+	// DisplayP3 is the wide-gamut color space of Apple displays and of the CSS display-p3 color space,
+	// with the DCI-P3 primaries, the D65 white point and the sRGB transfer function.
+	DisplayP3 = &ColorSpace{
+		Name:     "Display P3",
+		Red:      Chromaticity{X: 0.680, Y: 0.320},
+		Green:    Chromaticity{X: 0.265, Y: 0.690},
+		Blue:     Chromaticity{X: 0.150, Y: 0.060},
+		White:    D65,
+		Transfer: SRGB,
+	}
+	// AdobeRGB is the Adobe RGB (1998) color space of photography and print.
+	AdobeRGB = &ColorSpace{
+		Name:     "Adobe RGB (1998)",
+		Red:      Chromaticity{X: 0.64, Y: 0.33},
+		Green:    Chromaticity{X: 0.21, Y: 0.71},
+		Blue:     Chromaticity{X: 0.15, Y: 0.06},
+		White:    D65,
+		Transfer: Gamma(563.0 / 256.0),
+	}
+	// Rec2020 is the color space of ultra-high-definition video (ITU-R BT.2020).
+	Rec2020 = &ColorSpace{
+		Name:     "Rec. 2020",
+		Red:      Chromaticity{X: 0.708, Y: 0.292},
+		Green:    Chromaticity{X: 0.170, Y: 0.797},
+		Blue:     Chromaticity{X: 0.131, Y: 0.046},
+		White:    D65,
+		Transfer: Rec709,
+	}
+	// ProPhoto is the ProPhoto RGB (ROMM RGB) color space of photo editing, relative to the D50 white point.
+	ProPhoto = &ColorSpace{
+		Name:     "ProPhoto RGB",
+		Red:      Chromaticity{X: 0.7347, Y: 0.2653},
+		Green:    Chromaticity{X: 0.1596, Y: 0.8404},
+		Blue:     Chromaticity{X: 0.0366, Y: 0.0001},
+		White:    D50,
+		Transfer: &TransferFunction{encode: linearToROMM, decode: rommToLinear},
+	}
+)
+
+// Returns the name of the color space.
+func (s *ColorSpace) String() string {
+	return s.Name
+}
+
+// Returns the matrix that transforms linear colors of the color space to CIE XYZ, relative to its white point.
+func (s *ColorSpace) toXYZ() *matrix3 {
+	rx, ry, rz := s.Red.xyz()
+	gx, gy, gz := s.Green.xyz()
+	bx, by, bz := s.Blue.xyz()
+	m := &matrix3{{rx, gx, bx}, {ry, gy, by}, {rz, gz, bz}}
+	sr, sg, sb := m.inverse().mul(s.White.xyz())
+	return &matrix3{
+		{sr * rx, sg * gx, sb * bx},
+		{sr * ry, sg * gy, sb * by},
+		{sr * rz, sg * gz, sb * bz},
+	}
+}
+
+// Returns the matrix that transforms linear colors of the color space from into the color space to,
+// adapting them from the white point of from to that of to.
+func spaceTransform(from, to *ColorSpace) *matrix3 {
+	return to.toXYZ().inverse().product(bradford(from.White, to.White)).product(from.toXYZ())
+}
+
+// The Bradford matrix, which transforms CIE XYZ into the cone responses used by chromatic adaptation.
+var (
+	xyzToBradford = &matrix3{
+		{0.8951, 0.2664, -0.1614},
+		{-0.7502, 1.7135, 0.0367},
+		{0.0389, -0.0685, 1.0296},
+	}
+	bradfordToXYZ = xyzToBradford.inverse()
+)
+
+// Returns the matrix that adapts CIE XYZ colors from the white point from to the white point to,
+// with the Bradford chromatic adaptation transform.
+func bradford(from, to Chromaticity) *matrix3 {
+	fr, fg, fb := xyzToBradford.mul(from.xyz())
+	tr, tg, tb := xyzToBradford.mul(to.xyz())
+	scale := &matrix3{{tr / fr, 0, 0}, {0, tg / fg, 0}, {0, 0, tb / fb}}
+	return bradfordToXYZ.product(scale).product(xyzToBradford)
+}
+
+// Returns the color, whose channels are in the color space from, with its channels converted into the color space to.
+// Colors outside of the gamut of to are brought within it by the gamut mapping m. Alpha is left untouched.
+//
+// The channels of types that hold linear light, such as [RGBAF32] and [GrayF32], are taken as the linear channels
+// of the color spaces, and are converted without transfer functions. Their values above 1 are kept, so that
+// HDR colors only have their chromaticity mapped within the gamut of to.
+func ConvertSpace[T Color](c T, from, to *ColorSpace, m GamutMapping) T {
+	if from == to {
+		return c
+	}
+	return convertSpace[T](c, from, to, m, gamutOf(from), gamutOf(to))
+}
+
+// Returns a copy of the image, whose colors are in the color space from, with its colors converted into the color space to.
+// Colors outside of the gamut of to are brought within it by the gamut mapping m. Alpha is left untouched.
+//
+// [Compress] searches the boundary chroma of both gamuts, with a few dozen gamut tests each, for every color
+// beyond its knee. The boundaries are cached by lightness and hue, so that images with many repeated colors
+// pay this cost once per distinct color rather than once per pixel.
+func ConvertImageSpace[T Color](img Image[T], from, to *ColorSpace, m GamutMapping) *Grid[T] {
+	r := img.Bounds()
+	dst := NewImage[T](r)
+	gs, gd := gamutOf(from), gamutOf(to)
+	if m == Compress {
+		gs, gd = gs.cached(), gd.cached()
+	}
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		row := dst.Pix[dst.PixOffset(r.Min.X, y):][:r.Dx()]
+		for x := range row {
+			c := img.Get(r.Min.X+x, y)
+			if from != to {
+				c = convertSpace[T](c, from, to, m, gs, gd)
+			}
+			row[x] = c
+		}
+	}
+	return dst
+}
+
+// Returns the color converted between the color spaces from and to, whose gamuts are src and dst.
+// Colors of types that hold linear light, such as [RGBAF32], are converted without transfer functions,
+// and keep their dynamic range: their gamut is mapped after scaling them down until no channel of to exceeds 1.
+// Colors of the other types are decoded and encoded with the transfer functions of the color spaces.
+func convertSpace[T Color](c T, from, to *ColorSpace, m GamutMapping, src, dst gamut) T {
+	if l, ok := linearOf(c); ok {
+		r, g, b := src.toSRGB.mul(l.r, l.g, l.b)
+		dr, dg, db := dst.fromSRGB.mul(r, g, b)
+		scale := max(dr, dg, db, 1)
+		l = dst.clip(m.apply(linear{r: r / scale, g: g / scale, b: b / scale, a: l.a}, dst, &src))
+		r, g, b = dst.fromSRGB.mul(l.r, l.g, l.b)
+		if v, ok := fromLinear[T](linear{r: r * scale, g: g * scale, b: b * scale, a: l.a}); ok {
+			return v
+		}
+	}
+	r, g, b, a := wideOf(c).floats()
+	r, g, b = src.toSRGB.mul(from.Transfer.Decode(r), from.Transfer.Decode(g), from.Transfer.Decode(b))
+	l := m.apply(linear{r: r, g: g, b: b, a: a}, dst, &src)
+	r, g, b = dst.fromSRGB.mul(l.r, l.g, l.b)
+	return fromWide[T](wideFromFloats(to.Transfer.Encode(r), to.Transfer.Encode(g), to.Transfer.Encode(b), a))
+}
+
+// Converts a value from linear light to the ROMM RGB transfer function's encoded values.
+func linearToROMM(v float64) float64 {
+	if math.Abs(v) < 1.0/512 {
+		return v * 16
+	}
+	return math.Copysign(math.Pow(math.Abs(v), 1/1.8), v)
+}
+
+// Converts a value from the ROMM RGB transfer function's encoded values to linear light.
+func rommToLinear(v float64) float64 {
+	if math.Abs(v) < 16.0/512 {
+		return v / 16
+	}
+	return math.Copysign(math.Pow(math.Abs(v), 1.8), v)
+}
@@ -0,0 +1,126 @@
+package pxl_test
+
+import (
+	"fmt"
+	"image"
+	"pxl"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvertSpace(t *testing.T) {
+	t.Parallel()
+	spaces := []*pxl.ColorSpace{pxl.SRGBSpace, pxl.DisplayP3, pxl.AdobeRGB, pxl.Rec2020, pxl.ProPhoto}
+	t.Run("returns the expected channels", func(t *testing.T) {
+		testCases := []struct {
+			from *pxl.ColorSpace
+			to   *pxl.ColorSpace
+			c    pxl.RGBA64
+			e    pxl.RGBA64
+		}{{from: pxl.SRGBSpace, to: pxl.DisplayP3, c: pxl.RGBA64{R: 0xffff, A: 0xffff}, e: pxl.RGBA64{R: 0xeae0, G: 0x3345, B: 0x2378, A: 0xffff}},
+			{from: pxl.SRGBSpace, to: pxl.AdobeRGB, c: pxl.RGBA64{R: 0xffff, A: 0xffff}, e: pxl.RGBA64{R: 0xdbcc, A: 0xffff}},
+			{from: pxl.SRGBSpace, to: pxl.Rec2020, c: pxl.RGBA64{R: 0xffff, A: 0xffff}, e: pxl.RGBA64{R: 0xcabf, G: 0x3b21, B: 0x12e2, A: 0xffff}},
+			{from: pxl.SRGBSpace, to: pxl.ProPhoto, c: pxl.RGBA64{R: 0xffff, A: 0xffff}, e: pxl.RGBA64{R: 0xb3c6, G: 0x4695, B: 0x1a82, A: 0xffff}},
+			{from: pxl.DisplayP3, to: pxl.SRGBSpace, c: pxl.RGBA64{R: 0xeae0, G: 0x3345, B: 0x2378, A: 0x8000}, e: pxl.RGBA64{R: 0xffff, A: 0x8000}},
+			{from: pxl.DisplayP3, to: pxl.SRGBSpace, c: pxl.RGBA64{R: 0xffff, A: 0xffff}, e: pxl.RGBA64{R: 0xffff, A: 0xffff}}}
+		for _, testCase := range testCases {
+			t.Run(fmt.Sprintf("%v->%v/%+v", testCase.from, testCase.to, testCase.c), func(t *testing.T) {
+				c := pxl.ConvertSpace(testCase.c, testCase.from, testCase.to, pxl.Clip)
+				assert.InDelta(t, testCase.e.R, c.R, 2)
+				assert.InDelta(t, testCase.e.G, c.G, 2)
+				assert.InDelta(t, testCase.e.B, c.B, 2)
+				assert.Equal(t, testCase.e.A, c.A)
+			})
+		}
+	})
+	t.Run("maps white to white", func(t *testing.T) {
+		white := pxl.RGBA32{R: 0xff, G: 0xff, B: 0xff, A: 0xff}
+		for _, from := range spaces {
+			for _, to := range spaces {
+				t.Run(fmt.Sprintf("%v->%v", from, to), func(t *testing.T) {
+					assert.Equal(t, white, pxl.ConvertSpace(white, from, to, pxl.Clip))
+				})
+			}
+		}
+	})
+	t.Run("round-trips colors within both gamuts", func(t *testing.T) {
+		c := pxl.RGBA64{R: 0x8000, G: 0x6000, B: 0x4000, A: 0xffff}
+		for _, s := range spaces {
+			t.Run(s.String(), func(t *testing.T) {
+				r := pxl.ConvertSpace(pxl.ConvertSpace(c, pxl.SRGBSpace, s, pxl.Clip), s, pxl.SRGBSpace, pxl.Clip)
+				assert.InDelta(t, c.R, r.R, 2)
+				assert.InDelta(t, c.G, r.G, 2)
+				assert.InDelta(t, c.B, r.B, 2)
+			})
+		}
+	})
+	t.Run("converts linear light without transfer functions", func(t *testing.T) {
+		testCases := []struct {
+			c pxl.RGBAF32
+			e pxl.RGBAF32
+		}{{c: pxl.RGBAF32{R: 0.2, G: 0.2, B: 0.2, A: 1}, e: pxl.RGBAF32{R: 0.2, G: 0.2, B: 0.2, A: 1}},
+			{c: pxl.RGBAF32{R: 2, G: 2, B: 2, A: 0.5}, e: pxl.RGBAF32{R: 2, G: 2, B: 2, A: 0.5}},
+			{c: pxl.RGBAF32{R: 1, A: 1}, e: pxl.RGBAF32{R: 0.627404, G: 0.069097, B: 0.016391, A: 1}},
+			{c: pxl.RGBAF32{R: 4, A: 1}, e: pxl.RGBAF32{R: 2.509616, G: 0.276388, B: 0.065564, A: 1}}}
+		for _, testCase := range testCases {
+			t.Run(fmt.Sprintf("%+v", testCase.c), func(t *testing.T) {
+				for _, s := range spaces[1:] {
+					c := pxl.ConvertSpace(testCase.c, pxl.SRGBSpace, s, pxl.Clip)
+					if s == pxl.Rec2020 {
+						assert.InDelta(t, testCase.e.R, c.R, 1e-5)
+						assert.InDelta(t, testCase.e.G, c.G, 1e-5)
+						assert.InDelta(t, testCase.e.B, c.B, 1e-5)
+						assert.Equal(t, testCase.e.A, c.A)
+					}
+					r := pxl.ConvertSpace(c, s, pxl.SRGBSpace, pxl.Clip)
+					assert.InDelta(t, testCase.c.R, r.R, 1e-5, s)
+					assert.InDelta(t, testCase.c.G, r.G, 1e-5, s)
+					assert.InDelta(t, testCase.c.B, r.B, 1e-5, s)
+					assert.Equal(t, testCase.c.A, r.A, s)
+				}
+			})
+		}
+	})
+	t.Run("keeps linear grays gray", func(t *testing.T) {
+		for _, s := range spaces[1:] {
+			assert.InDelta(t, 0.2, float64(pxl.ConvertSpace(pxl.GrayF64(0.2), pxl.SRGBSpace, s, pxl.Clip)), 1e-9, s)
+			assert.InDelta(t, 3, float64(pxl.ConvertSpace(pxl.GrayF64(3), s, pxl.SRGBSpace, pxl.Clip)), 1e-9, s)
+		}
+	})
+	t.Run("returns colors of the same space untouched", func(t *testing.T) {
+		c := pxl.RGBA32{R: 0x12, G: 0x34, B: 0x56, A: 0x78}
+		assert.Equal(t, c, pxl.ConvertSpace(c, pxl.DisplayP3, pxl.DisplayP3, pxl.Clip))
+	})
+}
+
+func TestConvertImageSpace(t *testing.T) {
+	t.Parallel()
+	img := pxl.NewImage[pxl.RGBA32](image.Rect(-2, -2, 2, 2))
+	for i := range img.Pix {
+		img.Pix[i] = pxl.RGBA32{R: uint8(i * 16), G: 0x80, B: uint8(255 - i*16), A: 0xff}
+	}
+	t.Run("converts every pixel", func(t *testing.T) {
+		dst := pxl.ConvertImageSpace[pxl.RGBA32](img, pxl.DisplayP3, pxl.SRGBSpace, pxl.Clip)
+		assert.Equal(t, img.Bounds(), dst.Bounds())
+		for y := -2; y < 2; y++ {
+			for x := -2; x < 2; x++ {
+				assert.Equal(t, pxl.ConvertSpace(img.Get(x, y), pxl.DisplayP3, pxl.SRGBSpace, pxl.Clip), dst.Get(x, y))
+			}
+		}
+	})
+	t.Run("copies images of the same space", func(t *testing.T) {
+		dst := pxl.ConvertImageSpace[pxl.RGBA32](img.SubImage(image.Rect(0, 0, 2, 2)), pxl.SRGBSpace, pxl.SRGBSpace, pxl.Clip)
+		assert.Equal(t, image.Rect(0, 0, 2, 2), dst.Bounds())
+		assert.Equal(t, img.Get(1, 1), dst.Get(1, 1))
+		dst.Set(1, 1, pxl.RGBA32{})
+		assert.NotEqual(t, img.Get(1, 1), dst.Get(1, 1))
+	})
+}
+
+func BenchmarkConvertImageSpace(b *testing.B) {
+	img := pxl.NewImage[pxl.RGBA32](image.Rect(0, 0, 64, 64))
+	for i := 0; i < b.N; i++ {
+		_ = pxl.ConvertImageSpace[pxl.RGBA32](img, pxl.DisplayP3, pxl.SRGBSpace, pxl.Clip)
+	}
+}
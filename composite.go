@@ -0,0 +1,138 @@
+package pxl
+
+import (
+	"image"
+	"math/bits"
+	"strconv"
+)
+
+// An Operator is a Porter-Duff compositing operator, which combines a source color with a destination color.
+type Operator int
+
+// The twelve Porter-Duff compositing operators.
+const (
+	Clear   Operator = iota // neither source nor destination
+	Src                     // source only
+	Dst                     // destination only
+	SrcOver                 // source over destination
+	DstOver                 // destination over source
+	SrcIn                   // source within destination
+	DstIn                   // destination within source
+	SrcOut                  // source outside of destination
+	DstOut                  // destination outside of source
+	SrcAtop                 // source over destination, within destination
+	DstAtop                 // destination over source, within source
+	Xor                     // source outside of destination and destination outside of source
+)
+
+var operatorNames = [...]string{"Clear", "Src", "Dst", "SrcOver", "DstOver", "SrcIn", "DstIn", "SrcOut", "DstOut", "SrcAtop", "DstAtop", "Xor"}
+
+// Returns the name of the operator.
+func (op Operator) String() string {
+	if op < 0 || int(op) >= len(operatorNames) {
+		return "Operator(" + strconv.Itoa(int(op)) + ")"
+	}
+	return operatorNames[op]
+}
+
+// Returns the result of compositing the source color with the destination color, in the color type of the destination.
+// The computation is carried out with 64 bits per channel, so the precision of every color type is preserved.
+func Composite[T Color](dst T, src Color, op Operator) T {
+	return fromWide[T](op.composite(wideOf(dst), wideOf(src)))
+}
+
+// Composites the source image with the destination image, in place.
+// The source image is translated by offset, so that the source pixel at p is composited
+// with the destination pixel at p.Add(offset). Only the destination pixels covered by
+// the translated source image are modified. dst and src must not share pixels.
+func CompositeImage[T Color](dst, src Image[T], offset image.Point, op Operator) {
+	r := dst.Bounds().Intersect(src.Bounds().Add(offset))
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		for x := r.Min.X; x < r.Max.X; x++ {
+			dst.Set(x, y, Composite(dst.Get(x, y), src.Get(x-offset.X, y-offset.Y), op))
+		}
+	}
+}
+
+// Composites the source image with the destination image through a mask, in place.
+// It behaves like [CompositeImage], but the result at each pixel is interpolated towards the original
+// destination by the alpha of the mask, which is aligned with the source image. A nil mask is fully opaque.
+func CompositeImageMask[T Color](dst, src Image[T], offset image.Point, mask image.Image, op Operator) {
+	r := dst.Bounds().Intersect(src.Bounds().Add(offset))
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		for x := r.Min.X; x < r.Max.X; x++ {
+			d := wideOf(dst.Get(x, y))
+			c := op.composite(d, wideOf(src.Get(x-offset.X, y-offset.Y)))
+			dst.Set(x, y, fromWide[T](lerp(d, c, maskAt(mask, x-offset.X, y-offset.Y))))
+		}
+	}
+}
+
+// Returns the fractions of the source and destination that contribute to the result of the operator,
+// given the alpha of the destination and source colors.
+func (op Operator) factors(da, sa uint64) (fs, fd uint64) {
+	switch op {
+	case Src:
+		return wideMax, 0
+	case Dst:
+		return 0, wideMax
+	case SrcOver:
+		return wideMax, wideMax - sa
+	case DstOver:
+		return wideMax - da, wideMax
+	case SrcIn:
+		return da, 0
+	case DstIn:
+		return 0, sa
+	case SrcOut:
+		return wideMax - da, 0
+	case DstOut:
+		return 0, wideMax - sa
+	case SrcAtop:
+		return da, wideMax - sa
+	case DstAtop:
+		return wideMax - da, sa
+	case Xor:
+		return wideMax - da, wideMax - sa
+	}
+	return 0, 0
+}
+
+// Returns the result of compositing the source color with the destination color.
+func (op Operator) composite(d, s wide) wide {
+	fs, fd := op.factors(d.a, s.a)
+	ws := mulDiv(fs, s.a, wideMax)
+	wd := mulDiv(fd, d.a, wideMax)
+	// The weights sum to at most 1, but may exceed it by rounding.
+	if ws > wideMax-wd {
+		wd = wideMax - ws
+	}
+	a := ws + wd
+	if a == 0 {
+		return wide{}
+	}
+	return wide{r: mean(s.r, ws, d.r, wd), g: mean(s.g, ws, d.g, wd), b: mean(s.b, ws, d.b, wd), a: a}
+}
+
+// Returns the mean of x and y, weighted by wx and wy, rounded to the nearest integer.
+// The weights must not sum to zero or overflow.
+func mean(x, wx, y, wy uint64) uint64 {
+	if wy == 0 {
+		return x
+	}
+	if wx == 0 {
+		return y
+	}
+	xh, xl := bits.Mul64(x, wx)
+	yh, yl := bits.Mul64(y, wy)
+	lo, carry := bits.Add64(xl, yl, 0)
+	hi, _ := bits.Add64(xh, yh, carry)
+	w := wx + wy
+	lo, carry = bits.Add64(lo, w/2, 0)
+	hi += carry
+	if hi >= w {
+		return wideMax
+	}
+	q, _ := bits.Div64(hi, lo, w)
+	return q
+}
@@ -0,0 +1,119 @@
+package pxl_test
+
+import (
+	"fmt"
+	"image"
+	"pxl"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOperator(t *testing.T) {
+	t.Parallel()
+	t.Run("String()", func(t *testing.T) {
+		assert.Equal(t, "Clear", pxl.Clear.String())
+		assert.Equal(t, "SrcOver", pxl.SrcOver.String())
+		assert.Equal(t, "Xor", pxl.Xor.String())
+		assert.Equal(t, "Operator(12)", pxl.Operator(12).String())
+	})
+}
+
+func TestComposite(t *testing.T) {
+	t.Parallel()
+	t.Run("returns the correct value", func(t *testing.T) {
+		red := pxl.RGBA32{R: 0xff, G: 0x00, B: 0x00, A: 0xff}
+		halfRed := pxl.RGBA32{R: 0xff, G: 0x00, B: 0x00, A: 0x80}
+		blue := pxl.RGBA32{R: 0x00, G: 0x00, B: 0xff, A: 0xff}
+		halfBlue := pxl.RGBA32{R: 0x00, G: 0x00, B: 0xff, A: 0x80}
+		testCases := []struct {
+			dst pxl.RGBA32
+			src pxl.RGBA32
+			op  pxl.Operator
+			e   pxl.RGBA32
+		}{{dst: blue, src: red, op: pxl.Clear, e: pxl.RGBA32{}},
+			{dst: blue, src: halfRed, op: pxl.Src, e: halfRed},
+			{dst: halfBlue, src: red, op: pxl.Dst, e: halfBlue},
+			{dst: blue, src: halfRed, op: pxl.SrcOver, e: pxl.RGBA32{R: 0x80, G: 0x00, B: 0x7f, A: 0xff}},
+			{dst: blue, src: red, op: pxl.SrcOver, e: red},
+			{dst: pxl.RGBA32{}, src: halfRed, op: pxl.SrcOver, e: halfRed},
+			{dst: halfBlue, src: red, op: pxl.DstOver, e: pxl.RGBA32{R: 0x7f, G: 0x00, B: 0x80, A: 0xff}},
+			{dst: halfBlue, src: red, op: pxl.SrcIn, e: halfRed},
+			{dst: blue, src: halfRed, op: pxl.DstIn, e: halfBlue},
+			{dst: halfBlue, src: red, op: pxl.SrcOut, e: pxl.RGBA32{R: 0xff, G: 0x00, B: 0x00, A: 0x7f}},
+			{dst: blue, src: halfRed, op: pxl.DstOut, e: pxl.RGBA32{R: 0x00, G: 0x00, B: 0xff, A: 0x7f}},
+			{dst: blue, src: halfRed, op: pxl.SrcAtop, e: pxl.RGBA32{R: 0x80, G: 0x00, B: 0x7f, A: 0xff}},
+			{dst: halfBlue, src: red, op: pxl.DstAtop, e: pxl.RGBA32{R: 0x7f, G: 0x00, B: 0x80, A: 0xff}},
+			{dst: blue, src: halfRed, op: pxl.Xor, e: pxl.RGBA32{R: 0x00, G: 0x00, B: 0xff, A: 0x7f}},
+			{dst: blue, src: red, op: pxl.Xor, e: pxl.RGBA32{}}}
+		for _, testCase := range testCases {
+			t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+				assert.Equal(t, testCase.e, pxl.Composite(testCase.dst, testCase.src, testCase.op))
+			})
+		}
+	})
+	t.Run("preserves the precision of wide colors", func(t *testing.T) {
+		dst := pxl.RGBA256{R: 0x0123456789abcdef, G: 0x1111111111111111, B: 0xfedcba9876543210, A: 0xfedcba9876543210}
+		src := pxl.RGBA256{R: 0xffffffffffffffff, G: 0x0000000000000001, B: 0x8000000000000000, A: 0xffffffffffffffff}
+		assert.Equal(t, dst, pxl.Composite(dst, src, pxl.DstIn))
+		assert.Equal(t, src, pxl.Composite(dst, src, pxl.SrcOver))
+		assert.Equal(t, dst, pxl.Composite(dst, pxl.RGBA256{}, pxl.SrcOver))
+		half := pxl.RGBA256{R: 0xffffffffffffffff, A: 0x8000000000000000}
+		c := pxl.Composite(pxl.RGBA256{A: 0xffffffffffffffff}, half, pxl.SrcOver)
+		assert.Equal(t, pxl.RGBA256{R: 0x8000000000000000, A: 0xffffffffffffffff}, c)
+	})
+	t.Run("accepts any source color type", func(t *testing.T) {
+		assert.Equal(t, pxl.RGBA64{R: 0xffff, G: 0xffff, B: 0xffff, A: 0xffff}, pxl.Composite(pxl.RGBA64{}, pxl.Gray8(0xff), pxl.SrcOver))
+	})
+}
+
+func TestCompositeImage(t *testing.T) {
+	t.Parallel()
+	t.Run("composites the overlapping pixels", func(t *testing.T) {
+		blue := pxl.RGBA32{R: 0x00, G: 0x00, B: 0xff, A: 0xff}
+		halfRed := pxl.RGBA32{R: 0xff, G: 0x00, B: 0x00, A: 0x80}
+		dst := pxl.NewImage[pxl.RGBA32](image.Rect(0, 0, 4, 4))
+		for i := range dst.Pix {
+			dst.Pix[i] = blue
+		}
+		src := pxl.NewImage[pxl.RGBA32](image.Rect(-1, -1, 2, 2))
+		for i := range src.Pix {
+			src.Pix[i] = halfRed
+		}
+		pxl.CompositeImage[pxl.RGBA32](dst, src, image.Pt(2, 2), pxl.SrcOver)
+		for y := 0; y < 4; y++ {
+			for x := 0; x < 4; x++ {
+				e := blue
+				if x >= 1 && y >= 1 {
+					e = pxl.RGBA32{R: 0x80, G: 0x00, B: 0x7f, A: 0xff}
+				}
+				assert.Equal(t, e, dst.Get(x, y), "(%d, %d)", x, y)
+			}
+		}
+	})
+	t.Run("ignores sources outside of the destination", func(t *testing.T) {
+		dst := pxl.NewImage[pxl.RGBA32](image.Rect(0, 0, 2, 2))
+		src := pxl.NewImage[pxl.RGBA32](image.Rect(0, 0, 2, 2))
+		src.Pix[0] = pxl.RGBA32{R: 0xff, A: 0xff}
+		pxl.CompositeImage[pxl.RGBA32](dst, src, image.Pt(5, 5), pxl.Src)
+		assert.Equal(t, make([]pxl.RGBA32, 4), dst.Pix)
+	})
+}
+
+func BenchmarkComposite(b *testing.B) {
+	dst := pxl.RGBA32{R: 0x00, G: 0x00, B: 0xff, A: 0x80}
+	src := pxl.RGBA32{R: 0xff, G: 0x00, B: 0x00, A: 0x80}
+	b.Run("Composite()", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			pxl.Composite(dst, src, pxl.SrcOver)
+		}
+	})
+	b.Run("CompositeImage()", func(b *testing.B) {
+		d := pxl.NewImage[pxl.RGBA32](image.Rect(0, 0, 256, 256))
+		s := pxl.NewImage[pxl.RGBA32](image.Rect(0, 0, 256, 256))
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			pxl.CompositeImage[pxl.RGBA32](d, s, image.Point{}, pxl.SrcOver)
+		}
+	})
+}
@@ -0,0 +1,98 @@
+package pxl
+
+// Minimum contrast ratios required by the Web Content Accessibility Guidelines (WCAG) 2
+// between text and its background. Large text is at least 18 point, or 14 point bold.
+const (
+	ContrastAA       = 4.5 // level AA, normal text
+	ContrastAALarge  = 3   // level AA, large text
+	ContrastAAA      = 7   // level AAA, normal text
+	ContrastAAALarge = 4.5 // level AAA, large text
+)
+
+// Returns the relative luminance of the color, as defined by WCAG 2, within [0, 1].
+// Alpha is ignored.
+func RelativeLuminance(c Color) float64 {
+	r, g, b, _ := wideOf(c).floats()
+	return relativeLuminance(r, g, b)
+}
+
+// Returns the contrast ratio, as defined by WCAG 2, of a foreground color over a background color.
+// The ratio ranges within [1, 21]. A translucent foreground is composited over the background,
+// and the alpha of the background is ignored.
+func ContrastRatio(fg, bg Color) float64 {
+	r1, g1, b1, a1 := wideOf(fg).floats()
+	r2, g2, b2, _ := wideOf(bg).floats()
+	l1 := relativeLuminance(r1*a1+r2*(1-a1), g1*a1+g2*(1-a1), b1*a1+b2*(1-a1))
+	l2 := relativeLuminance(r2, g2, b2)
+	return (max(l1, l2) + 0.05) / (min(l1, l2) + 0.05)
+}
+
+// Reports whether a foreground color over a background color meets WCAG 2 level AA.
+func PassesAA(fg, bg Color, large bool) bool {
+	if large {
+		return ContrastRatio(fg, bg) >= ContrastAALarge
+	}
+	return ContrastRatio(fg, bg) >= ContrastAA
+}
+
+// Reports whether a foreground color over a background color meets WCAG 2 level AAA.
+func PassesAAA(fg, bg Color, large bool) bool {
+	if large {
+		return ContrastRatio(fg, bg) >= ContrastAAALarge
+	}
+	return ContrastRatio(fg, bg) >= ContrastAAA
+}
+
+// Returns the foreground color closest in lightness to fg whose contrast ratio over bg is at least ratio.
+// The lightness is moved towards black or white in Oklab, keeping the hue and alpha of fg, and
+// reducing its chroma proportionally so that the result stays within the sRGB gamut.
+// If fg already meets the ratio it is returned unchanged. If no lightness meets the ratio,
+// the color with the highest contrast ratio is returned, and ok is false.
+func AdjustContrast[T Color](fg T, bg Color, ratio float64) (c T, ok bool) {
+	if ContrastRatio(fg, bg) >= ratio {
+		return fg, true
+	}
+	from := convert[OKLab](fg)
+	// Moves the color towards black (l = 0) or white (l = 1) by the fraction t.
+	toward := func(l, t float64) T {
+		return convert[T](OKLab{L: from.L + (l-from.L)*t, A: from.A * (1 - t), B: from.B * (1 - t), Alpha: from.Alpha})
+	}
+	// Returns the smallest change in lightness towards l that meets the ratio.
+	search := func(l float64) (T, float64, bool) {
+		c := toward(l, 1)
+		if ContrastRatio(c, bg) < ratio {
+			return c, 0, false
+		}
+		lo, hi := 0.0, 1.0
+		for i := 0; i < 32; i++ {
+			t := (lo + hi) / 2
+			if ContrastRatio(toward(l, t), bg) >= ratio {
+				hi = t
+			} else {
+				lo = t
+			}
+		}
+		return toward(l, hi), hi * max(from.L-l, l-from.L), true
+	}
+	dark, dd, dok := search(0)
+	light, dl, lok := search(1)
+	switch {
+	case dok && lok:
+		if dd <= dl {
+			return dark, true
+		}
+		return light, true
+	case dok:
+		return dark, true
+	case lok:
+		return light, true
+	case ContrastRatio(dark, bg) >= ContrastRatio(light, bg):
+		return dark, false
+	}
+	return light, false
+}
+
+// Returns the relative luminance of a gamma-encoded sRGB color, as defined by WCAG 2.
+func relativeLuminance(r, g, b float64) float64 {
+	return 0.2126*srgbToLinear(r) + 0.7152*srgbToLinear(g) + 0.0722*srgbToLinear(b)
+}
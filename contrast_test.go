@@ -0,0 +1,122 @@
+package pxl_test
+
+import (
+	"fmt"
+	"pxl"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRelativeLuminance(t *testing.T) {
+	t.Parallel()
+	t.Run("returns the correct value", func(t *testing.T) {
+		testCases := []struct {
+			c pxl.Color
+			e float64
+		}{{c: pxl.Gray8(0x00), e: 0},
+			{c: pxl.Gray8(0xff), e: 1},
+			{c: pxl.RGBA32{R: 0xff, G: 0x00, B: 0x00, A: 0xff}, e: 0.2126},
+			{c: pxl.RGBA32{R: 0x00, G: 0xff, B: 0x00, A: 0x00}, e: 0.7152},
+			{c: pxl.RGBA32{R: 0x77, G: 0x77, B: 0x77, A: 0xff}, e: 0.184475}}
+		for _, testCase := range testCases {
+			t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+				assert.InDelta(t, testCase.e, pxl.RelativeLuminance(testCase.c), 1e-6)
+			})
+		}
+	})
+}
+
+func TestContrastRatio(t *testing.T) {
+	t.Parallel()
+	t.Run("returns the correct value", func(t *testing.T) {
+		testCases := []struct {
+			fg pxl.Color
+			bg pxl.Color
+			e  float64
+		}{{fg: pxl.Gray8(0x00), bg: pxl.Gray8(0xff), e: 21},
+			{fg: pxl.Gray8(0xff), bg: pxl.Gray8(0x00), e: 21},
+			{fg: pxl.Gray8(0x80), bg: pxl.Gray8(0x80), e: 1},
+			{fg: pxl.RGBA32{R: 0x77, G: 0x77, B: 0x77, A: 0xff}, bg: pxl.Gray8(0xff), e: 4.4781},
+			{fg: pxl.RGBA32{R: 0x00, G: 0x00, B: 0xff, A: 0xff}, bg: pxl.Gray8(0xff), e: 8.5924},
+			{fg: pxl.RGBA32{R: 0x00, G: 0x00, B: 0x00, A: 0x00}, bg: pxl.Gray8(0xff), e: 1},
+			{fg: pxl.RGBA32{R: 0x00, G: 0x00, B: 0x00, A: 0x88}, bg: pxl.Gray8(0xff), e: 4.4781}}
+		for _, testCase := range testCases {
+			t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+				assert.InDelta(t, testCase.e, pxl.ContrastRatio(testCase.fg, testCase.bg), 1e-4)
+			})
+		}
+	})
+}
+
+func TestPassesAA(t *testing.T) {
+	t.Parallel()
+	white := pxl.Gray8(0xff)
+	assert.False(t, pxl.PassesAA(pxl.RGBA32{R: 0x77, G: 0x77, B: 0x77, A: 0xff}, white, false))
+	assert.True(t, pxl.PassesAA(pxl.RGBA32{R: 0x77, G: 0x77, B: 0x77, A: 0xff}, white, true))
+	assert.True(t, pxl.PassesAA(pxl.RGBA32{R: 0x76, G: 0x76, B: 0x76, A: 0xff}, white, false))
+}
+
+func TestPassesAAA(t *testing.T) {
+	t.Parallel()
+	white := pxl.Gray8(0xff)
+	assert.False(t, pxl.PassesAAA(pxl.RGBA32{R: 0x76, G: 0x76, B: 0x76, A: 0xff}, white, false))
+	assert.True(t, pxl.PassesAAA(pxl.RGBA32{R: 0x76, G: 0x76, B: 0x76, A: 0xff}, white, true))
+	assert.True(t, pxl.PassesAAA(pxl.RGBA32{R: 0x59, G: 0x59, B: 0x59, A: 0xff}, white, false))
+}
+
+func TestAdjustContrast(t *testing.T) {
+	t.Parallel()
+	t.Run("returns colors that meet the ratio unchanged", func(t *testing.T) {
+		c := pxl.RGBA32{R: 0x00, G: 0x00, B: 0xff, A: 0xff}
+		e, ok := pxl.AdjustContrast(c, pxl.Gray8(0xff), pxl.ContrastAA)
+		assert.True(t, ok)
+		assert.Equal(t, c, e)
+	})
+	t.Run("returns the nearest color that meets the ratio", func(t *testing.T) {
+		testCases := []struct {
+			fg    pxl.RGBA32
+			bg    pxl.Color
+			ratio float64
+			e     pxl.RGBA32
+		}{{fg: pxl.RGBA32{R: 0x77, G: 0x77, B: 0x77, A: 0xff}, bg: pxl.Gray8(0xff), ratio: pxl.ContrastAA, e: pxl.RGBA32{R: 0x76, G: 0x76, B: 0x76, A: 0xff}},
+			{fg: pxl.RGBA32{R: 0x77, G: 0x77, B: 0x77, A: 0xff}, bg: pxl.Gray8(0xff), ratio: pxl.ContrastAAA, e: pxl.RGBA32{R: 0x59, G: 0x59, B: 0x59, A: 0xff}},
+			{fg: pxl.RGBA32{R: 0x77, G: 0x77, B: 0x77, A: 0xff}, bg: pxl.Gray8(0x00), ratio: pxl.ContrastAAA, e: pxl.RGBA32{R: 0x95, G: 0x95, B: 0x95, A: 0xff}}}
+		for _, testCase := range testCases {
+			t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+				c, ok := pxl.AdjustContrast(testCase.fg, testCase.bg, testCase.ratio)
+				assert.True(t, ok)
+				assert.Equal(t, testCase.e, c)
+			})
+		}
+	})
+	t.Run("keeps the hue and alpha of the color", func(t *testing.T) {
+		fg := pxl.RGBA32{R: 0xff, G: 0x80, B: 0x00, A: 0xcc}
+		bg := pxl.Gray8(0xff)
+		c, ok := pxl.AdjustContrast(fg, bg, pxl.ContrastAA)
+		assert.True(t, ok)
+		assert.Equal(t, fg.A, c.A)
+		assert.GreaterOrEqual(t, pxl.ContrastRatio(c, bg), pxl.ContrastAA)
+		assert.InDelta(t, pxl.Convert[pxl.OKLCh](fg).H, pxl.Convert[pxl.OKLCh](c).H, 2)
+	})
+	t.Run("reports ratios that cannot be met", func(t *testing.T) {
+		c, ok := pxl.AdjustContrast(pxl.RGBA32{R: 0x77, G: 0x77, B: 0x77, A: 0x40}, pxl.Gray8(0xff), pxl.ContrastAA)
+		assert.False(t, ok)
+		assert.Equal(t, pxl.RGBA32{R: 0x00, G: 0x00, B: 0x00, A: 0x40}, c)
+	})
+}
+
+func BenchmarkContrast(b *testing.B) {
+	fg := pxl.RGBA32{R: 0x77, G: 0x77, B: 0x77, A: 0xff}
+	bg := pxl.Gray8(0xff)
+	b.Run("ContrastRatio()", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			pxl.ContrastRatio(fg, bg)
+		}
+	})
+	b.Run("AdjustContrast()", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			pxl.AdjustContrast(fg, bg, pxl.ContrastAAA)
+		}
+	})
+}
@@ -0,0 +1,371 @@
+package pxl
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+	"math/bits"
+)
+
+// Converts a color from one pxl color type to another.
+//
+// Conversions never drop to the 16 bits per channel returned by RGBA().
+// Every channel is read from its native representation, scaled to a
+// non-alpha-premultiplied 64-bit intermediate, and then scaled to the
+// precision of the destination type, rounding to the nearest representable
+// value with ties rounded up. Widening conversions are therefore exact, and
+// narrowing conversions are correctly rounded, even between types such as
+// [RGBA128], [RGBA256], [Gray32] and [Gray64] that are wider than 16 bits.
+//
+// Converting a color into a gray type uses the same luminance coefficients as
+// the standard library's [color.GrayModel]. Translucent colors are treated
+// as if they were composited over black, since gray types are always opaque.
+//
+// Colors outside of the range of To, such as [OKLCh] colors outside of the sRGB gamut,
+// are clipped; see [MapGamut] for other gamut mappings.
+//
+// To must be one of the color types of this package.
+func Convert[To, From Color](c From) To {
+	return convert[To](c)
+}
+
+// Converts any color into T. See [Convert].
+func convert[T Color](c color.Color) T {
+	if v, ok := c.(T); ok {
+		return v
+	}
+	if cc, m, y, k, ok := inksOf(c); ok {
+		if v, ok := fromInks[T](cc, m, y, k); ok {
+			return v
+		}
+	}
+	if l, ok := linearOf(c); ok {
+		if v, ok := fromLinear[T](l); ok {
+			return v
+		}
+	}
+	return fromWide[T](wideOf(c))
+}
+
+// A wide is a non-alpha-premultiplied color whose channels each range within
+// [0, 0xffffffffffffffff].
+type wide struct {
+	r, g, b, a uint64
+}
+
+// The maximum value of a wide channel.
+const wideMax = 0xffffffffffffffff
+
+// Returns the wide representation of the color.
+// Colors of this package are read from their native channels.
+// Any other color is read through RGBA().
+func wideOf(c color.Color) wide {
+	switch c := c.(type) {
+	case RGBA8:
+		return wide{
+			r: expand(uint64(c>>6&0x03), 0x03),
+			g: expand(uint64(c>>4&0x03), 0x03),
+			b: expand(uint64(c>>2&0x03), 0x03),
+			a: expand(uint64(c&0x03), 0x03),
+		}
+	case RGBA16:
+		return wide{
+			r: expand(uint64(c>>12&0x0f), 0x0f),
+			g: expand(uint64(c>>8&0x0f), 0x0f),
+			b: expand(uint64(c>>4&0x0f), 0x0f),
+			a: expand(uint64(c&0x0f), 0x0f),
+		}
+	case RGB565:
+		return wide{r: expand(uint64(c>>11&0x1f), 0x1f), g: expand(uint64(c>>5&0x3f), 0x3f), b: expand(uint64(c&0x1f), 0x1f), a: wideMax}
+	case RGB555:
+		return wide{r: expand(uint64(c>>10&0x1f), 0x1f), g: expand(uint64(c>>5&0x1f), 0x1f), b: expand(uint64(c&0x1f), 0x1f), a: wideMax}
+	case ARGB1555:
+		return wide{r: expand(uint64(c>>10&0x1f), 0x1f), g: expand(uint64(c>>5&0x1f), 0x1f), b: expand(uint64(c&0x1f), 0x1f), a: expand(uint64(c>>15), 0x01)}
+	case RGB444:
+		return wide{r: expand(uint64(c>>8&0x0f), 0x0f), g: expand(uint64(c>>4&0x0f), 0x0f), b: expand(uint64(c&0x0f), 0x0f), a: wideMax}
+	case RGBA32:
+		return wide{r: uint64(c.R) * 0x0101010101010101, g: uint64(c.G) * 0x0101010101010101, b: uint64(c.B) * 0x0101010101010101, a: uint64(c.A) * 0x0101010101010101}
+	case RGBA64:
+		return wide{r: uint64(c.R) * 0x0001000100010001, g: uint64(c.G) * 0x0001000100010001, b: uint64(c.B) * 0x0001000100010001, a: uint64(c.A) * 0x0001000100010001}
+	case RGBA128:
+		return wide{r: uint64(c.R) * 0x0000000100000001, g: uint64(c.G) * 0x0000000100000001, b: uint64(c.B) * 0x0000000100000001, a: uint64(c.A) * 0x0000000100000001}
+	case RGBA256:
+		return wide{r: c.R, g: c.G, b: c.B, a: c.A}
+	case BGRA32:
+		return wide{r: uint64(c.R) * 0x0101010101010101, g: uint64(c.G) * 0x0101010101010101, b: uint64(c.B) * 0x0101010101010101, a: uint64(c.A) * 0x0101010101010101}
+	case ARGB32:
+		return wide{r: uint64(c.R) * 0x0101010101010101, g: uint64(c.G) * 0x0101010101010101, b: uint64(c.B) * 0x0101010101010101, a: uint64(c.A) * 0x0101010101010101}
+	case ABGR32:
+		return wide{r: uint64(c.R) * 0x0101010101010101, g: uint64(c.G) * 0x0101010101010101, b: uint64(c.B) * 0x0101010101010101, a: uint64(c.A) * 0x0101010101010101}
+	case BGRA64:
+		return wide{r: uint64(c.R) * 0x0001000100010001, g: uint64(c.G) * 0x0001000100010001, b: uint64(c.B) * 0x0001000100010001, a: uint64(c.A) * 0x0001000100010001}
+	case ARGB64:
+		return wide{r: uint64(c.R) * 0x0001000100010001, g: uint64(c.G) * 0x0001000100010001, b: uint64(c.B) * 0x0001000100010001, a: uint64(c.A) * 0x0001000100010001}
+	case ABGR64:
+		return wide{r: uint64(c.R) * 0x0001000100010001, g: uint64(c.G) * 0x0001000100010001, b: uint64(c.B) * 0x0001000100010001, a: uint64(c.A) * 0x0001000100010001}
+	case PRGBA32:
+		return unpremultiply(uint64(c.R), uint64(c.G), uint64(c.B), uint64(c.A), 0xff)
+	case PRGBA64:
+		return unpremultiply(uint64(c.R), uint64(c.G), uint64(c.B), uint64(c.A), 0xffff)
+	case PRGBA128:
+		return unpremultiply(uint64(c.R), uint64(c.G), uint64(c.B), uint64(c.A), 0xffffffff)
+	case PRGBA256:
+		return unpremultiply(c.R, c.G, c.B, c.A, wideMax)
+	case CMYK32:
+		return inks(expand(uint64(c.C), 0xff), expand(uint64(c.M), 0xff), expand(uint64(c.Y), 0xff), expand(uint64(c.K), 0xff))
+	case CMYK64:
+		return inks(expand(uint64(c.C), 0xffff), expand(uint64(c.M), 0xffff), expand(uint64(c.Y), 0xffff), expand(uint64(c.K), 0xffff))
+	case Gray8:
+		y := uint64(c) * 0x0101010101010101
+		return wide{r: y, g: y, b: y, a: wideMax}
+	case Gray16:
+		y := uint64(c) * 0x0001000100010001
+		return wide{r: y, g: y, b: y, a: wideMax}
+	case Gray32:
+		y := uint64(c) * 0x0000000100000001
+		return wide{r: y, g: y, b: y, a: wideMax}
+	case Gray64:
+		y := uint64(c)
+		return wide{r: y, g: y, b: y, a: wideMax}
+	case GrayA16:
+		y := uint64(c.Y) * 0x0101010101010101
+		return wide{r: y, g: y, b: y, a: uint64(c.A) * 0x0101010101010101}
+	case GrayA32:
+		y := uint64(c.Y) * 0x0001000100010001
+		return wide{r: y, g: y, b: y, a: uint64(c.A) * 0x0001000100010001}
+	case GrayA64:
+		y := uint64(c.Y) * 0x0000000100000001
+		return wide{r: y, g: y, b: y, a: uint64(c.A) * 0x0000000100000001}
+	case GrayA128:
+		return wide{r: c.Y, g: c.Y, b: c.Y, a: c.A}
+	case Mono:
+		if c {
+			return wide{r: wideMax, g: wideMax, b: wideMax, a: wideMax}
+		}
+		return wide{a: wideMax}
+	case Alpha1:
+		if c {
+			return wide{r: wideMax, g: wideMax, b: wideMax, a: wideMax}
+		}
+		return wide{r: wideMax, g: wideMax, b: wideMax}
+	case Alpha8:
+		return wide{r: wideMax, g: wideMax, b: wideMax, a: uint64(c) * 0x0101010101010101}
+	case Alpha16:
+		return wide{r: wideMax, g: wideMax, b: wideMax, a: uint64(c) * 0x0001000100010001}
+	case HSL:
+		r, g, b := hslToRGB(c.H, c.S, c.L)
+		return wideFromFloats(r, g, b, c.A)
+	case HSV:
+		r, g, b := hsvToRGB(c.H, c.S, c.V)
+		return wideFromFloats(r, g, b, c.A)
+	case YCbCr:
+		r, g, b := c.Encoding.decode(c.Y, c.Cb, c.Cr)
+		return wideFromFloats(r, g, b, 1)
+	case Lab, LCh, OKLab, OKLCh, RGBAF32, RGBAF64, GrayF32, GrayF64:
+		l, _ := linearOf(c)
+		return l.wide()
+	}
+	r, g, b, a := c.RGBA()
+	if a == 0 {
+		return wide{}
+	}
+	w := wide{
+		r: uint64(r) * 0x0001000100010001,
+		g: uint64(g) * 0x0001000100010001,
+		b: uint64(b) * 0x0001000100010001,
+		a: uint64(a) * 0x0001000100010001,
+	}
+	w.r = mulDiv(w.r, wideMax, w.a)
+	w.g = mulDiv(w.g, wideMax, w.a)
+	w.b = mulDiv(w.b, wideMax, w.a)
+	return w
+}
+
+// Returns the color of type T closest to the wide color.
+func fromWide[T Color](w wide) T {
+	var c T
+	switch p := any(&c).(type) {
+	case *RGBA8:
+		*p = RGBA8(quantize(w.r, 0x03)<<6 | quantize(w.g, 0x03)<<4 | quantize(w.b, 0x03)<<2 | quantize(w.a, 0x03))
+	case *RGBA16:
+		*p = RGBA16(quantize(w.r, 0x0f)<<12 | quantize(w.g, 0x0f)<<8 | quantize(w.b, 0x0f)<<4 | quantize(w.a, 0x0f))
+	case *RGB565:
+		*p = RGB565(quantize(w.r, 0x1f)<<11 | quantize(w.g, 0x3f)<<5 | quantize(w.b, 0x1f))
+	case *RGB555:
+		*p = RGB555(quantize(w.r, 0x1f)<<10 | quantize(w.g, 0x1f)<<5 | quantize(w.b, 0x1f))
+	case *ARGB1555:
+		*p = ARGB1555(quantize(w.a, 0x01)<<15 | quantize(w.r, 0x1f)<<10 | quantize(w.g, 0x1f)<<5 | quantize(w.b, 0x1f))
+	case *RGB444:
+		*p = RGB444(quantize(w.r, 0x0f)<<8 | quantize(w.g, 0x0f)<<4 | quantize(w.b, 0x0f))
+	case *RGBA32:
+		*p = RGBA32{R: uint8(quantize(w.r, 0xff)), G: uint8(quantize(w.g, 0xff)), B: uint8(quantize(w.b, 0xff)), A: uint8(quantize(w.a, 0xff))}
+	case *RGBA64:
+		*p = RGBA64{R: uint16(quantize(w.r, 0xffff)), G: uint16(quantize(w.g, 0xffff)), B: uint16(quantize(w.b, 0xffff)), A: uint16(quantize(w.a, 0xffff))}
+	case *RGBA128:
+		*p = RGBA128{R: uint32(quantize(w.r, 0xffffffff)), G: uint32(quantize(w.g, 0xffffffff)), B: uint32(quantize(w.b, 0xffffffff)), A: uint32(quantize(w.a, 0xffffffff))}
+	case *RGBA256:
+		*p = RGBA256{R: w.r, G: w.g, B: w.b, A: w.a}
+	case *BGRA32:
+		*p = BGRA32{R: uint8(quantize(w.r, 0xff)), G: uint8(quantize(w.g, 0xff)), B: uint8(quantize(w.b, 0xff)), A: uint8(quantize(w.a, 0xff))}
+	case *ARGB32:
+		*p = ARGB32{R: uint8(quantize(w.r, 0xff)), G: uint8(quantize(w.g, 0xff)), B: uint8(quantize(w.b, 0xff)), A: uint8(quantize(w.a, 0xff))}
+	case *ABGR32:
+		*p = ABGR32{R: uint8(quantize(w.r, 0xff)), G: uint8(quantize(w.g, 0xff)), B: uint8(quantize(w.b, 0xff)), A: uint8(quantize(w.a, 0xff))}
+	case *BGRA64:
+		*p = BGRA64{R: uint16(quantize(w.r, 0xffff)), G: uint16(quantize(w.g, 0xffff)), B: uint16(quantize(w.b, 0xffff)), A: uint16(quantize(w.a, 0xffff))}
+	case *ARGB64:
+		*p = ARGB64{R: uint16(quantize(w.r, 0xffff)), G: uint16(quantize(w.g, 0xffff)), B: uint16(quantize(w.b, 0xffff)), A: uint16(quantize(w.a, 0xffff))}
+	case *ABGR64:
+		*p = ABGR64{R: uint16(quantize(w.r, 0xffff)), G: uint16(quantize(w.g, 0xffff)), B: uint16(quantize(w.b, 0xffff)), A: uint16(quantize(w.a, 0xffff))}
+	case *PRGBA32:
+		r, g, b, a := w.premultiply(0xff)
+		*p = PRGBA32{R: uint8(r), G: uint8(g), B: uint8(b), A: uint8(a)}
+	case *PRGBA64:
+		r, g, b, a := w.premultiply(0xffff)
+		*p = PRGBA64{R: uint16(r), G: uint16(g), B: uint16(b), A: uint16(a)}
+	case *PRGBA128:
+		r, g, b, a := w.premultiply(0xffffffff)
+		*p = PRGBA128{R: uint32(r), G: uint32(g), B: uint32(b), A: uint32(a)}
+	case *PRGBA256:
+		r, g, b, a := w.premultiply(wideMax)
+		*p = PRGBA256{R: r, G: g, B: b, A: a}
+	case *CMYK32:
+		*p, _ = fromInks[CMYK32](w.inks())
+	case *CMYK64:
+		*p, _ = fromInks[CMYK64](w.inks())
+	case *Gray8:
+		*p = Gray8(quantize(w.luma(), 0xff))
+	case *Gray16:
+		*p = Gray16(quantize(w.luma(), 0xffff))
+	case *Gray32:
+		*p = Gray32(quantize(w.luma(), 0xffffffff))
+	case *Gray64:
+		*p = Gray64(w.luma())
+	case *GrayA16:
+		*p = GrayA16{Y: uint8(quantize(w.opaque().luma(), 0xff)), A: uint8(quantize(w.a, 0xff))}
+	case *GrayA32:
+		*p = GrayA32{Y: uint16(quantize(w.opaque().luma(), 0xffff)), A: uint16(quantize(w.a, 0xffff))}
+	case *GrayA64:
+		*p = GrayA64{Y: uint32(quantize(w.opaque().luma(), 0xffffffff)), A: uint32(quantize(w.a, 0xffffffff))}
+	case *GrayA128:
+		*p = GrayA128{Y: w.opaque().luma(), A: w.a}
+	case *Mono:
+		*p = w.luma() > wideMax/2
+	case *Alpha1:
+		*p = w.a > wideMax/2
+	case *Alpha8:
+		*p = Alpha8(quantize(w.a, 0xff))
+	case *Alpha16:
+		*p = Alpha16(quantize(w.a, 0xffff))
+	case *HSL:
+		r, g, b, a := w.floats()
+		h, s, l := rgbToHSL(r, g, b)
+		*p = HSL{H: h, S: s, L: l, A: a}
+	case *HSV:
+		r, g, b, a := w.floats()
+		h, s, v := rgbToHSV(r, g, b)
+		*p = HSV{H: h, S: s, V: v, A: a}
+	case *YCbCr:
+		r, g, b, a := w.floats()
+		*p = YCbCrEncoding{}.encode(r*a, g*a, b*a)
+	case *Lab, *LCh, *OKLab, *OKLCh, *RGBAF32, *RGBAF64, *GrayF32, *GrayF64:
+		c, _ = fromLinear[T](w.linear())
+	default:
+		panic(fmt.Sprintf("pxl: cannot convert into %T", c))
+	}
+	return c
+}
+
+// Returns the luminance of the color, as if it were composited over black.
+func (w wide) luma() uint64 {
+	// 19595 + 38470 + 7471 = 65536, so the weighted sum fits within 80 bits
+	// and the result fits within 64 bits.
+	rh, rl := bits.Mul64(19595, w.r)
+	gh, gl := bits.Mul64(38470, w.g)
+	bh, bl := bits.Mul64(7471, w.b)
+	lo, carry := bits.Add64(rl, gl, 0)
+	hi, _ := bits.Add64(rh, gh, carry)
+	lo, carry = bits.Add64(lo, bl, 0)
+	hi, _ = bits.Add64(hi, bh, carry)
+	lo, carry = bits.Add64(lo, 1<<15, 0)
+	hi, _ = bits.Add64(hi, 0, carry)
+	y := hi<<48 | lo>>16
+	return mulDiv(y, w.a, wideMax)
+}
+
+// Returns the wide color printed with the given inks, each within [0, 0xffffffffffffffff].
+func inks(c, m, y, k uint64) wide {
+	return wide{r: mulDiv(wideMax-c, wideMax-k, wideMax), g: mulDiv(wideMax-m, wideMax-k, wideMax), b: mulDiv(wideMax-y, wideMax-k, wideMax), a: wideMax}
+}
+
+// Returns the inks that print the wide color composited over black, with the whole gray component
+// replaced by black, as the standard library's [image/color.RGBToCMYK] does.
+func (w wide) inks() (c, m, y, k uint64) {
+	r, g, b := mulDiv(w.r, w.a, wideMax), mulDiv(w.g, w.a, wideMax), mulDiv(w.b, w.a, wideMax)
+	v := max(r, g, b)
+	if v == 0 {
+		return 0, 0, 0, wideMax
+	}
+	return mulDiv(v-r, wideMax, v), mulDiv(v-g, wideMax, v), mulDiv(v-b, wideMax, v), wideMax - v
+}
+
+// Returns the wide color with its alpha set to opaque.
+func (w wide) opaque() wide {
+	w.a = wideMax
+	return w
+}
+
+// Returns the wide color whose channels are closest to the given channels,
+// each of which is clamped to [0, 1].
+func wideFromFloats(r, g, b, a float64) wide {
+	return wide{r: wideChannel(r), g: wideChannel(g), b: wideChannel(b), a: wideChannel(a)}
+}
+
+// Scales v from [0, 1] to [0, 0xffffffffffffffff], clamping and rounding to the nearest integer.
+func wideChannel(v float64) uint64 {
+	switch {
+	case !(v > 0): // Also catches NaN.
+		return 0
+	case v >= 1:
+		return wideMax
+	}
+	return uint64(math.Round(v * (1 << 64)))
+}
+
+// Returns the channels of the wide color, each scaled to [0, 1].
+func (w wide) floats() (r, g, b, a float64) {
+	return float64(w.r) / wideMax, float64(w.g) / wideMax, float64(w.b) / wideMax, float64(w.a) / wideMax
+}
+
+// Returns the alpha-premultiplied red, green, blue and alpha values
+// for the wide color, as returned by RGBA().
+func (w wide) rgba() (r, g, b, a uint32) {
+	a = uint32(quantize(w.a, 0xffff))
+	r = uint32(quantize(mulDiv(w.r, w.a, wideMax), 0xffff))
+	g = uint32(quantize(mulDiv(w.g, w.a, wideMax), 0xffff))
+	b = uint32(quantize(mulDiv(w.b, w.a, wideMax), 0xffff))
+	return
+}
+
+// Scales v from [0, 0xffffffffffffffff] to [0, max], rounding to the nearest integer.
+func quantize(v, max uint64) uint64 {
+	return mulDiv(v, max, wideMax)
+}
+
+// Scales v from [0, max] to [0, 0xffffffffffffffff], rounding to the nearest integer.
+func expand(v, max uint64) uint64 {
+	return mulDiv(v, wideMax, max)
+}
+
+// Returns x*y/z rounded to the nearest integer, with ties rounded up.
+// Results that do not fit within 64 bits saturate to 0xffffffffffffffff.
+func mulDiv(x, y, z uint64) uint64 {
+	hi, lo := bits.Mul64(x, y)
+	lo, carry := bits.Add64(lo, z/2, 0)
+	hi += carry
+	if hi >= z {
+		return wideMax
+	}
+	q, _ := bits.Div64(hi, lo, z)
+	return q
+}
@@ -0,0 +1,120 @@
+package pxl_test
+
+import (
+	"fmt"
+	"math"
+	"pxl"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvert(t *testing.T) {
+	t.Parallel()
+	t.Run("returns colors of the same type untouched", func(t *testing.T) {
+		c := pxl.RGBA256{R: 0x0123456789abcdef, G: 0xfedcba9876543210, B: 0x00ff00ff00ff00ff, A: 0x0000000000000001}
+		assert.Equal(t, c, pxl.Convert[pxl.RGBA256](c))
+	})
+	t.Run("widens every RGBA8 color exactly", func(t *testing.T) {
+		for i := 0; i <= 0xff; i++ {
+			c := pxl.RGBA8(i)
+			r, g, b, a := uint8(i>>6&0x03)*0x55, uint8(i>>4&0x03)*0x55, uint8(i>>2&0x03)*0x55, uint8(i&0x03)*0x55
+			e := pxl.RGBA32{R: r, G: g, B: b, A: a}
+			assert.Equal(t, e, pxl.Convert[pxl.RGBA32](c))
+			assert.Equal(t, c, pxl.Convert[pxl.RGBA8](e))
+			assert.Equal(t, c, pxl.Convert[pxl.RGBA8](pxl.Convert[pxl.RGBA256](c)))
+		}
+	})
+	t.Run("widens every RGBA16 color exactly", func(t *testing.T) {
+		for i := 0; i <= 0xffff; i++ {
+			c := pxl.RGBA16(i)
+			r, g, b, a := uint8(i>>12&0x0f)*0x11, uint8(i>>8&0x0f)*0x11, uint8(i>>4&0x0f)*0x11, uint8(i&0x0f)*0x11
+			e := pxl.RGBA32{R: r, G: g, B: b, A: a}
+			if e != pxl.Convert[pxl.RGBA32](c) || c != pxl.Convert[pxl.RGBA16](e) || c != pxl.Convert[pxl.RGBA16](pxl.Convert[pxl.RGBA128](c)) {
+				assert.Fail(t, "conversion failed", "%04x", i)
+			}
+		}
+	})
+	t.Run("round-trips every RGBA32 channel and alpha through RGBA64", func(t *testing.T) {
+		for v := 0; v <= 0xff; v++ {
+			for a := 0; a <= 0xff; a++ {
+				c := pxl.RGBA32{R: uint8(v), G: uint8(0xff - v), B: uint8(v ^ 0x5a), A: uint8(a)}
+				if c != pxl.Convert[pxl.RGBA32](pxl.Convert[pxl.RGBA64](c)) {
+					assert.Fail(t, "round trip failed", "%+v", c)
+				}
+			}
+		}
+	})
+	t.Run("rounds every RGBA32 channel to the nearest RGBA8 and RGBA16 channel", func(t *testing.T) {
+		for v := 0; v <= 0xff; v++ {
+			c := pxl.RGBA32{R: uint8(v), G: uint8(v), B: uint8(v), A: uint8(v)}
+			q2 := uint8(math.Floor(float64(v)*3/255 + 0.5))
+			q4 := uint8(math.Floor(float64(v)*15/255 + 0.5))
+			assert.Equal(t, pxl.RGBA8(q2<<6|q2<<4|q2<<2|q2), pxl.Convert[pxl.RGBA8](c))
+			assert.Equal(t, pxl.RGBA16(uint16(q4)<<12|uint16(q4)<<8|uint16(q4)<<4|uint16(q4)), pxl.Convert[pxl.RGBA16](c))
+		}
+	})
+	t.Run("converts every Gray8 color exactly", func(t *testing.T) {
+		for v := 0; v <= 0xff; v++ {
+			c := pxl.Gray8(v)
+			assert.Equal(t, pxl.RGBA32{R: uint8(v), G: uint8(v), B: uint8(v), A: 0xff}, pxl.Convert[pxl.RGBA32](c))
+			assert.Equal(t, pxl.Gray16(v*0x0101), pxl.Convert[pxl.Gray16](c))
+			assert.Equal(t, c, pxl.Convert[pxl.Gray8](pxl.Convert[pxl.Gray64](c)))
+			assert.Equal(t, c, pxl.Convert[pxl.Gray8](pxl.Convert[pxl.RGBA32](c)))
+		}
+	})
+	t.Run("rounds every Gray16 color to the nearest Gray8 color", func(t *testing.T) {
+		for v := 0; v <= 0xffff; v++ {
+			e := pxl.Gray8(math.Floor(float64(v)*255/65535 + 0.5))
+			if e != pxl.Convert[pxl.Gray8](pxl.Gray16(v)) {
+				assert.Fail(t, "conversion failed", "%04x", v)
+			}
+		}
+	})
+	t.Run("keeps the full precision of wide types", func(t *testing.T) {
+		testCases := []struct {
+			c pxl.Color
+			e pxl.Color
+		}{{c: pxl.Gray32(0xaa55aaff), e: pxl.RGBA128{R: 0xaa55aaff, G: 0xaa55aaff, B: 0xaa55aaff, A: 0xffffffff}},
+			{c: pxl.Gray64(0xaaaa5555aaaaffff), e: pxl.Gray32(0xaaaa5555)},
+			{c: pxl.Gray64(0xaaaa55562aaa5555), e: pxl.Gray32(0xaaaa5555)},
+			{c: pxl.Gray64(0xaaaa55562aaa5556), e: pxl.Gray32(0xaaaa5556)},
+			{c: pxl.RGBA128{R: 0x12345678, G: 0x9abcdef0, B: 0x0fedcba9, A: 0x87654321}, e: pxl.RGBA256{R: 0x1234567812345678, G: 0x9abcdef09abcdef0, B: 0x0fedcba90fedcba9, A: 0x8765432187654321}},
+			{c: pxl.RGBA256{R: 0x1234567812345678, G: 0x9abcdef09abcdef0, B: 0x0fedcba90fedcba9, A: 0x8765432187654321}, e: pxl.RGBA128{R: 0x12345678, G: 0x9abcdef0, B: 0x0fedcba9, A: 0x87654321}},
+			{c: pxl.RGBA256{R: 0x0000000080000001, G: 0x0000000080000000, B: 0xffffffff7ffffffe, A: 0xffffffffffffffff}, e: pxl.RGBA128{R: 0x00000001, G: 0x00000000, B: 0xfffffffe, A: 0xffffffff}},
+			{c: pxl.RGBA128{R: 0xffffffff, G: 0x00000000, B: 0x00000000, A: 0x00000001}, e: pxl.RGBA128{R: 0xffffffff, G: 0x00000000, B: 0x00000000, A: 0x00000001}},
+			{c: pxl.RGBA128{R: 0xffffffff, G: 0xffffffff, B: 0xffffffff, A: 0x80000000}, e: pxl.Gray32(0x80000000)}}
+		for _, testCase := range testCases {
+			t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+				var a pxl.Color
+				switch testCase.e.(type) {
+				case pxl.RGBA128:
+					a = pxl.Convert[pxl.RGBA128](testCase.c)
+				case pxl.RGBA256:
+					a = pxl.Convert[pxl.RGBA256](testCase.c)
+				case pxl.Gray32:
+					a = pxl.Convert[pxl.Gray32](testCase.c)
+				}
+				assert.Equal(t, testCase.e, a)
+			})
+		}
+	})
+}
+
+func BenchmarkConvert(b *testing.B) {
+	b.Run("RGBA32 to RGBA16", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			pxl.Convert[pxl.RGBA16](pxl.RGBA32{R: uint8(i), G: uint8(i >> 8), B: uint8(i >> 16), A: uint8(i >> 24)})
+		}
+	})
+	b.Run("RGBA256 to RGBA128", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			pxl.Convert[pxl.RGBA128](pxl.RGBA256{R: uint64(i), G: uint64(i) << 16, B: uint64(i) << 32, A: uint64(i) << 48})
+		}
+	})
+	b.Run("RGBA64 to Gray8", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			pxl.Convert[pxl.Gray8](pxl.RGBA64{R: uint16(i), G: uint16(i >> 4), B: uint16(i >> 8), A: 0xffff})
+		}
+	})
+}
@@ -0,0 +1,131 @@
+package pxl_test
+
+import (
+	"errors"
+	"fmt"
+	"pxl"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCSS(t *testing.T) {
+	t.Parallel()
+	t.Run("parses valid colors", func(t *testing.T) {
+		testCases := []struct {
+			s string
+			c pxl.RGBA64
+		}{{s: "#f00", c: pxl.RGBA64{R: 0xffff, G: 0x0000, B: 0x0000, A: 0xffff}},
+			{s: "#F008", c: pxl.RGBA64{R: 0xffff, G: 0x0000, B: 0x0000, A: 0x8888}},
+			{s: "#663399", c: pxl.RGBA64{R: 0x6666, G: 0x3333, B: 0x9999, A: 0xffff}},
+			{s: "#66339980", c: pxl.RGBA64{R: 0x6666, G: 0x3333, B: 0x9999, A: 0x8080}},
+			{s: "  RebeccaPurple ", c: pxl.RGBA64{R: 0x6666, G: 0x3333, B: 0x9999, A: 0xffff}},
+			{s: "transparent", c: pxl.RGBA64{}},
+			{s: "rgb(255, 0, 0)", c: pxl.RGBA64{R: 0xffff, G: 0x0000, B: 0x0000, A: 0xffff}},
+			{s: "rgba(255,0,0,0.5)", c: pxl.RGBA64{R: 0xffff, G: 0x0000, B: 0x0000, A: 0x8000}},
+			{s: "rgb(100%, 50%, 0%, 25%)", c: pxl.RGBA64{R: 0xffff, G: 0x8000, B: 0x0000, A: 0x4000}},
+			{s: "rgb(102 51 153)", c: pxl.RGBA64{R: 0x6666, G: 0x3333, B: 0x9999, A: 0xffff}},
+			{s: "rgb(102 51 153 / 50%)", c: pxl.RGBA64{R: 0x6666, G: 0x3333, B: 0x9999, A: 0x8000}},
+			{s: "rgba(40% 51 none / .5)", c: pxl.RGBA64{R: 0x6666, G: 0x3333, B: 0x0000, A: 0x8000}},
+			{s: "rgb(300 -20 1e2)", c: pxl.RGBA64{R: 0xffff, G: 0x0000, B: 0x6464, A: 0xffff}},
+			{s: "hsl(0, 100%, 50%)", c: pxl.RGBA64{R: 0xffff, G: 0x0000, B: 0x0000, A: 0xffff}},
+			{s: "hsla(120, 100%, 25%, 0.5)", c: pxl.RGBA64{R: 0x0000, G: 0x8000, B: 0x0000, A: 0x8000}},
+			{s: "hsl(270deg 50% 40%)", c: pxl.RGBA64{R: 0x6666, G: 0x3333, B: 0x9999, A: 0xffff}},
+			{s: "hsl(0.75turn 50 40)", c: pxl.RGBA64{R: 0x6666, G: 0x3333, B: 0x9999, A: 0xffff}},
+			{s: "hsl(300grad 50% 40%)", c: pxl.RGBA64{R: 0x6666, G: 0x3333, B: 0x9999, A: 0xffff}},
+			{s: "hsl(-90 50% 40%)", c: pxl.RGBA64{R: 0x6666, G: 0x3333, B: 0x9999, A: 0xffff}},
+			{s: "hwb(270 20% 40%)", c: pxl.RGBA64{R: 0x6666, G: 0x3333, B: 0x9999, A: 0xffff}},
+			{s: "hwb(0 60% 60% / 1)", c: pxl.RGBA64{R: 0x8000, G: 0x8000, B: 0x8000, A: 0xffff}}}
+		for _, testCase := range testCases {
+			t.Run(testCase.s, func(t *testing.T) {
+				c, err := pxl.ParseCSS(testCase.s)
+				assert.NoError(t, err)
+				assert.Equal(t, testCase.c, c)
+			})
+		}
+	})
+	t.Run("returns typed errors for invalid colors", func(t *testing.T) {
+		testCases := []struct {
+			s   string
+			err error
+		}{{s: "", err: pxl.ErrCSSName},
+			{s: "notacolor", err: pxl.ErrCSSName},
+			{s: "currentcolor", err: pxl.ErrCSSName},
+			{s: "#ff", err: pxl.ErrCSSSyntax},
+			{s: "#fffff", err: pxl.ErrCSSSyntax},
+			{s: "#ggg", err: pxl.ErrCSSSyntax},
+			{s: "rgb(255 0 0", err: pxl.ErrCSSSyntax},
+			{s: "rgb(255 0)", err: pxl.ErrCSSSyntax},
+			{s: "rgb(255 0 0 0)", err: pxl.ErrCSSSyntax},
+			{s: "rgb(255, 0%, 0)", err: pxl.ErrCSSSyntax},
+			{s: "rgb(255, none, 0)", err: pxl.ErrCSSSyntax},
+			{s: "rgb(1,2,3,)", err: pxl.ErrCSSSyntax},
+			{s: "rgb(1,,3)", err: pxl.ErrCSSSyntax},
+			{s: "rgb(255 0 0 /)", err: pxl.ErrCSSSyntax},
+			{s: "rgb(255px 0 0)", err: pxl.ErrCSSSyntax},
+			{s: "rgb(. 0 0)", err: pxl.ErrCSSSyntax},
+			{s: "hsl(0, 100, 50)", err: pxl.ErrCSSSyntax},
+			{s: "hsl(0px 100% 50%)", err: pxl.ErrCSSSyntax},
+			{s: "hwb(0, 10%, 10%)", err: pxl.ErrCSSSyntax},
+			{s: "lab(50 0 0)", err: pxl.ErrCSSSyntax}}
+		for _, testCase := range testCases {
+			t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+				c, err := pxl.ParseCSS(testCase.s)
+				assert.ErrorIs(t, err, testCase.err)
+				var cssErr *pxl.CSSError
+				assert.True(t, errors.As(err, &cssErr))
+				assert.Equal(t, testCase.s, cssErr.Input)
+				assert.Equal(t, pxl.RGBA64{}, c)
+			})
+		}
+	})
+}
+
+func TestCSS(t *testing.T) {
+	t.Parallel()
+	t.Run("returns the correct value", func(t *testing.T) {
+		testCases := []struct {
+			c   interface{ CSS() string }
+			css string
+		}{{c: pxl.RGBA8(0x9b), css: "#aa55aa"},
+			{c: pxl.RGBA8(0x9a), css: "#aa55aaaa"},
+			{c: pxl.RGBA16(0xa5af), css: "#aa55aa"},
+			{c: pxl.RGBA32{R: 0x66, G: 0x33, B: 0x99, A: 0x80}, css: "#66339980"},
+			{c: pxl.RGBA64{R: 0x6666, G: 0x3333, B: 0x9999, A: 0xffff}, css: "#663399"},
+			{c: pxl.RGBA64{R: 0x8000, G: 0x0000, B: 0xffff, A: 0x8000}, css: "rgb(127.502 0 255 / 0.500008)"},
+			{c: pxl.RGBA128{R: 0xffffffff, G: 0x00000000, B: 0x00000000, A: 0xffffffff}, css: "#ff0000"},
+			{c: pxl.RGBA128{R: 0x80000000, G: 0x00000000, B: 0x00000000, A: 0xffffffff}, css: "rgb(127.50000003 0 0)"},
+			{c: pxl.RGBA256{R: 0x8000000000000000, G: 0x0000000000000000, B: 0x0000000000000000, A: 0x0000000000000000}, css: "rgb(127.5 0 0 / 0)"}}
+		for _, testCase := range testCases {
+			t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+				assert.Equal(t, testCase.css, testCase.c.CSS())
+			})
+		}
+	})
+	t.Run("round-trips RGBA64 colors through ParseCSS", func(t *testing.T) {
+		for i := 0; i <= 0xffff; i += 0x0101 / 3 {
+			c := pxl.RGBA64{R: uint16(i), G: uint16(0xffff - i), B: uint16(i * 7), A: uint16(i * 13)}
+			p, err := pxl.ParseCSS(c.CSS())
+			assert.NoError(t, err)
+			assert.Equal(t, c, p, c.CSS())
+		}
+	})
+}
+
+func BenchmarkParseCSS(b *testing.B) {
+	b.Run("hex", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = pxl.ParseCSS("#66339980")
+		}
+	})
+	b.Run("rgb()", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = pxl.ParseCSS("rgb(102 51 153 / 50%)")
+		}
+	})
+	b.Run("named", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = pxl.ParseCSS("rebeccapurple")
+		}
+	})
+}
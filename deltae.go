@@ -0,0 +1,88 @@
+package pxl
+
+import "math"
+
+// Returns the CIE 1976 color difference between two colors, the Euclidean distance in CIE L*a*b*.
+// A difference of about 2.3 is just noticeable. Alpha is ignored.
+func DeltaE76(c1, c2 Color) float64 {
+	l1, l2 := convert[Lab](c1), convert[Lab](c2)
+	return math.Sqrt(sq(l1.L-l2.L) + sq(l1.A-l2.A) + sq(l1.B-l2.B))
+}
+
+// Returns the CIE 1994 color difference between two colors, with the weights for graphic arts.
+// The difference is not symmetric: c1 is the reference color. Alpha is ignored.
+func DeltaE94(c1, c2 Color) float64 {
+	const kL, k1, k2 = 1, 0.045, 0.015
+	l1, l2 := convert[Lab](c1), convert[Lab](c2)
+	dl := l1.L - l2.L
+	ch1 := math.Hypot(l1.A, l1.B)
+	ch2 := math.Hypot(l2.A, l2.B)
+	dc := ch1 - ch2
+	dh2 := max(sq(l1.A-l2.A)+sq(l1.B-l2.B)-sq(dc), 0)
+	sc := 1 + k1*ch1
+	sh := 1 + k2*ch1
+	return math.Sqrt(sq(dl/kL) + sq(dc/sc) + dh2/sq(sh))
+}
+
+// Returns the CIEDE2000 color difference between two colors, with unit weights.
+// A difference of 1 is roughly just noticeable. Alpha is ignored.
+func DeltaE2000(c1, c2 Color) float64 {
+	l1, l2 := convert[Lab](c1), convert[Lab](c2)
+	// Adjust the a* axis to improve the performance for neutral colors.
+	cMean := (math.Hypot(l1.A, l1.B) + math.Hypot(l2.A, l2.B)) / 2
+	c7 := math.Pow(cMean, 7)
+	g := (1 - math.Sqrt(c7/(c7+math.Pow(25, 7)))) / 2
+	a1, a2 := l1.A*(1+g), l2.A*(1+g)
+	ch1, h1 := toPolar(a1, l1.B)
+	ch2, h2 := toPolar(a2, l2.B)
+	// Differences in lightness, chroma and hue.
+	dl := l2.L - l1.L
+	dc := ch2 - ch1
+	var dh float64
+	if ch1*ch2 != 0 {
+		dh = h2 - h1
+		if dh > 180 {
+			dh -= 360
+		} else if dh < -180 {
+			dh += 360
+		}
+	}
+	dH := 2 * math.Sqrt(ch1*ch2) * math.Sin(dh*math.Pi/360)
+	// Means of lightness, chroma and hue.
+	lMean := (l1.L + l2.L) / 2
+	cMean = (ch1 + ch2) / 2
+	hMean := h1 + h2
+	if ch1*ch2 != 0 {
+		if math.Abs(h1-h2) > 180 {
+			if hMean < 360 {
+				hMean += 360
+			} else {
+				hMean -= 360
+			}
+		}
+		hMean /= 2
+	}
+	// Weighting functions.
+	rad := math.Pi / 180
+	t := 1 - 0.17*math.Cos((hMean-30)*rad) + 0.24*math.Cos(2*hMean*rad) +
+		0.32*math.Cos((3*hMean+6)*rad) - 0.20*math.Cos((4*hMean-63)*rad)
+	sl := 1 + 0.015*sq(lMean-50)/math.Sqrt(20+sq(lMean-50))
+	sc := 1 + 0.045*cMean
+	sh := 1 + 0.015*cMean*t
+	c7 = math.Pow(cMean, 7)
+	rt := -2 * math.Sqrt(c7/(c7+math.Pow(25, 7))) * math.Sin(60*rad*math.Exp(-sq((hMean-275)/25)))
+	return math.Sqrt(sq(dl/sl) + sq(dc/sc) + sq(dH/sh) + rt*(dc/sc)*(dH/sh))
+}
+
+// Returns the color difference between two colors as the Euclidean distance in Oklab.
+// Oklab is more perceptually uniform than CIE L*a*b*, so this is a simple, cheap metric,
+// with a difference of about 0.02 being just noticeable. Alpha is ignored.
+func DeltaEOK(c1, c2 Color) float64 {
+	l1, l2 := convert[OKLab](c1), convert[OKLab](c2)
+	return math.Sqrt(sq(l1.L-l2.L) + sq(l1.A-l2.A) + sq(l1.B-l2.B))
+}
+
+// Returns the square of v.
+func sq(v float64) float64 {
+	return v * v
+}
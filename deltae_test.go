@@ -0,0 +1,150 @@
+package pxl_test
+
+import (
+	"fmt"
+	"math"
+	"pxl"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeltaE76(t *testing.T) {
+	t.Parallel()
+	t.Run("returns the correct value", func(t *testing.T) {
+		testCases := []struct {
+			c1 pxl.Color
+			c2 pxl.Color
+			e  float64
+		}{{c1: pxl.Lab{L: 50, A: 0, B: 0, Alpha: 1}, c2: pxl.Lab{L: 50, A: 0, B: 0, Alpha: 1}, e: 0},
+			{c1: pxl.Lab{L: 50, A: 3, B: 0, Alpha: 1}, c2: pxl.Lab{L: 50, A: 0, B: 4, Alpha: 1}, e: 5},
+			{c1: pxl.Lab{L: 50, A: 2.6772, B: -79.7751, Alpha: 1}, c2: pxl.Lab{L: 50, A: 0, B: -82.7485, Alpha: 1}, e: 4.0011},
+			{c1: pxl.RGBA32{R: 0x00, G: 0x00, B: 0x00, A: 0xff}, c2: pxl.Gray8(0xff), e: 100}}
+		for _, testCase := range testCases {
+			t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+				assert.InDelta(t, testCase.e, pxl.DeltaE76(testCase.c1, testCase.c2), 1e-4)
+				assert.InDelta(t, testCase.e, pxl.DeltaE76(testCase.c2, testCase.c1), 1e-4)
+			})
+		}
+	})
+}
+
+func TestDeltaE94(t *testing.T) {
+	t.Parallel()
+	t.Run("returns the correct value", func(t *testing.T) {
+		testCases := []struct {
+			c1 pxl.Color
+			c2 pxl.Color
+			e  float64
+		}{{c1: pxl.Lab{L: 50, A: 0, B: 0, Alpha: 1}, c2: pxl.Lab{L: 50, A: 0, B: 0, Alpha: 1}, e: 0},
+			{c1: pxl.Lab{L: 50, A: 0, B: 0, Alpha: 1}, c2: pxl.Lab{L: 60, A: 0, B: 0, Alpha: 1}, e: 10},
+			{c1: pxl.Lab{L: 50, A: 2.6772, B: -79.7751, Alpha: 1}, c2: pxl.Lab{L: 50, A: 0, B: -82.7485, Alpha: 1}, e: 1.3950},
+			{c1: pxl.Lab{L: 50, A: 0, B: -82.7485, Alpha: 1}, c2: pxl.Lab{L: 50, A: 2.6772, B: -79.7751, Alpha: 1}, e: 1.3653}}
+		for _, testCase := range testCases {
+			t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+				assert.InDelta(t, testCase.e, pxl.DeltaE94(testCase.c1, testCase.c2), 1e-4)
+			})
+		}
+	})
+}
+
+func TestDeltaE2000(t *testing.T) {
+	t.Parallel()
+	t.Run("returns the correct value", func(t *testing.T) {
+		// The test data published by G. Sharma, W. Wu and E. N. Dalal,
+		// "The CIEDE2000 color-difference formula: implementation notes,
+		// supplementary test data, and mathematical observations".
+		testCases := []struct {
+			c1 pxl.Lab
+			c2 pxl.Lab
+			e  float64
+		}{{c1: pxl.Lab{L: 50.0000, A: 2.6772, B: -79.7751, Alpha: 1}, c2: pxl.Lab{L: 50.0000, A: 0.0000, B: -82.7485, Alpha: 1}, e: 2.0425},
+			{c1: pxl.Lab{L: 50.0000, A: 3.1571, B: -77.2803, Alpha: 1}, c2: pxl.Lab{L: 50.0000, A: 0.0000, B: -82.7485, Alpha: 1}, e: 2.8615},
+			{c1: pxl.Lab{L: 50.0000, A: 2.8361, B: -74.0200, Alpha: 1}, c2: pxl.Lab{L: 50.0000, A: 0.0000, B: -82.7485, Alpha: 1}, e: 3.4412},
+			{c1: pxl.Lab{L: 50.0000, A: -1.3802, B: -84.2814, Alpha: 1}, c2: pxl.Lab{L: 50.0000, A: 0.0000, B: -82.7485, Alpha: 1}, e: 1.0000},
+			{c1: pxl.Lab{L: 50.0000, A: -1.1848, B: -84.8006, Alpha: 1}, c2: pxl.Lab{L: 50.0000, A: 0.0000, B: -82.7485, Alpha: 1}, e: 1.0000},
+			{c1: pxl.Lab{L: 50.0000, A: -0.9009, B: -85.5211, Alpha: 1}, c2: pxl.Lab{L: 50.0000, A: 0.0000, B: -82.7485, Alpha: 1}, e: 1.0000},
+			{c1: pxl.Lab{L: 50.0000, A: 0.0000, B: 0.0000, Alpha: 1}, c2: pxl.Lab{L: 50.0000, A: -1.0000, B: 2.0000, Alpha: 1}, e: 2.3669},
+			{c1: pxl.Lab{L: 50.0000, A: -1.0000, B: 2.0000, Alpha: 1}, c2: pxl.Lab{L: 50.0000, A: 0.0000, B: 0.0000, Alpha: 1}, e: 2.3669},
+			{c1: pxl.Lab{L: 50.0000, A: 2.4900, B: -0.0010, Alpha: 1}, c2: pxl.Lab{L: 50.0000, A: -2.4900, B: 0.0009, Alpha: 1}, e: 7.1792},
+			{c1: pxl.Lab{L: 50.0000, A: 2.4900, B: -0.0010, Alpha: 1}, c2: pxl.Lab{L: 50.0000, A: -2.4900, B: 0.0010, Alpha: 1}, e: 7.1792},
+			{c1: pxl.Lab{L: 50.0000, A: 2.4900, B: -0.0010, Alpha: 1}, c2: pxl.Lab{L: 50.0000, A: -2.4900, B: 0.0011, Alpha: 1}, e: 7.2195},
+			{c1: pxl.Lab{L: 50.0000, A: 2.4900, B: -0.0010, Alpha: 1}, c2: pxl.Lab{L: 50.0000, A: -2.4900, B: 0.0012, Alpha: 1}, e: 7.2195},
+			{c1: pxl.Lab{L: 50.0000, A: -0.0010, B: 2.4900, Alpha: 1}, c2: pxl.Lab{L: 50.0000, A: 0.0009, B: -2.4900, Alpha: 1}, e: 4.8045},
+			{c1: pxl.Lab{L: 50.0000, A: -0.0010, B: 2.4900, Alpha: 1}, c2: pxl.Lab{L: 50.0000, A: 0.0010, B: -2.4900, Alpha: 1}, e: 4.8045},
+			{c1: pxl.Lab{L: 50.0000, A: -0.0010, B: 2.4900, Alpha: 1}, c2: pxl.Lab{L: 50.0000, A: 0.0011, B: -2.4900, Alpha: 1}, e: 4.7461},
+			{c1: pxl.Lab{L: 50.0000, A: 2.5000, B: 0.0000, Alpha: 1}, c2: pxl.Lab{L: 50.0000, A: 0.0000, B: -2.5000, Alpha: 1}, e: 4.3065},
+			{c1: pxl.Lab{L: 50.0000, A: 2.5000, B: 0.0000, Alpha: 1}, c2: pxl.Lab{L: 73.0000, A: 25.0000, B: -18.0000, Alpha: 1}, e: 27.1492},
+			{c1: pxl.Lab{L: 50.0000, A: 2.5000, B: 0.0000, Alpha: 1}, c2: pxl.Lab{L: 61.0000, A: -5.0000, B: 29.0000, Alpha: 1}, e: 22.8977},
+			{c1: pxl.Lab{L: 50.0000, A: 2.5000, B: 0.0000, Alpha: 1}, c2: pxl.Lab{L: 56.0000, A: -27.0000, B: -3.0000, Alpha: 1}, e: 31.9030},
+			{c1: pxl.Lab{L: 50.0000, A: 2.5000, B: 0.0000, Alpha: 1}, c2: pxl.Lab{L: 58.0000, A: 24.0000, B: 15.0000, Alpha: 1}, e: 19.4535},
+			{c1: pxl.Lab{L: 50.0000, A: 2.5000, B: 0.0000, Alpha: 1}, c2: pxl.Lab{L: 50.0000, A: 3.1736, B: 0.5854, Alpha: 1}, e: 1.0000},
+			{c1: pxl.Lab{L: 50.0000, A: 2.5000, B: 0.0000, Alpha: 1}, c2: pxl.Lab{L: 50.0000, A: 3.2972, B: 0.0000, Alpha: 1}, e: 1.0000},
+			{c1: pxl.Lab{L: 50.0000, A: 2.5000, B: 0.0000, Alpha: 1}, c2: pxl.Lab{L: 50.0000, A: 1.8634, B: 0.5757, Alpha: 1}, e: 1.0000},
+			{c1: pxl.Lab{L: 50.0000, A: 2.5000, B: 0.0000, Alpha: 1}, c2: pxl.Lab{L: 50.0000, A: 3.2592, B: 0.3350, Alpha: 1}, e: 1.0000},
+			{c1: pxl.Lab{L: 60.2574, A: -34.0099, B: 36.2677, Alpha: 1}, c2: pxl.Lab{L: 60.4626, A: -34.1751, B: 39.4387, Alpha: 1}, e: 1.2644},
+			{c1: pxl.Lab{L: 63.0109, A: -31.0961, B: -5.8663, Alpha: 1}, c2: pxl.Lab{L: 62.8187, A: -29.7946, B: -4.0864, Alpha: 1}, e: 1.2630},
+			{c1: pxl.Lab{L: 61.2901, A: 3.7196, B: -5.3901, Alpha: 1}, c2: pxl.Lab{L: 61.4292, A: 2.2480, B: -4.9620, Alpha: 1}, e: 1.8731},
+			{c1: pxl.Lab{L: 35.0831, A: -44.1164, B: 3.7933, Alpha: 1}, c2: pxl.Lab{L: 35.0232, A: -40.0716, B: 1.5901, Alpha: 1}, e: 1.8645},
+			{c1: pxl.Lab{L: 22.7233, A: 20.0904, B: -46.6940, Alpha: 1}, c2: pxl.Lab{L: 23.0331, A: 14.9730, B: -42.5619, Alpha: 1}, e: 2.0373},
+			{c1: pxl.Lab{L: 36.4612, A: 47.8580, B: 18.3852, Alpha: 1}, c2: pxl.Lab{L: 36.2715, A: 50.5065, B: 21.2231, Alpha: 1}, e: 1.4146},
+			{c1: pxl.Lab{L: 90.8027, A: -2.0831, B: 1.4410, Alpha: 1}, c2: pxl.Lab{L: 91.1528, A: -1.6435, B: 0.0447, Alpha: 1}, e: 1.4441},
+			{c1: pxl.Lab{L: 90.9257, A: -0.5406, B: -0.9208, Alpha: 1}, c2: pxl.Lab{L: 88.6381, A: -0.8985, B: -0.7239, Alpha: 1}, e: 1.5381},
+			{c1: pxl.Lab{L: 6.7747, A: -0.2908, B: -2.4247, Alpha: 1}, c2: pxl.Lab{L: 5.8714, A: -0.0985, B: -2.2286, Alpha: 1}, e: 0.6377},
+			{c1: pxl.Lab{L: 2.0776, A: 0.0795, B: -1.1350, Alpha: 1}, c2: pxl.Lab{L: 0.9033, A: -0.0636, B: -0.5514, Alpha: 1}, e: 0.9082}}
+		for _, testCase := range testCases {
+			t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+				assert.InDelta(t, testCase.e, pxl.DeltaE2000(testCase.c1, testCase.c2), 1e-4)
+				assert.InDelta(t, testCase.e, pxl.DeltaE2000(testCase.c2, testCase.c1), 1e-4)
+			})
+		}
+	})
+	t.Run("returns zero for identical colors", func(t *testing.T) {
+		for _, c := range []pxl.Color{pxl.RGBA32{R: 0x12, G: 0x34, B: 0x56, A: 0xff}, pxl.Gray16(0x8000), pxl.Lab{}} {
+			assert.Equal(t, 0.0, pxl.DeltaE2000(c, c))
+		}
+	})
+}
+
+func TestDeltaEOK(t *testing.T) {
+	t.Parallel()
+	t.Run("returns the correct value", func(t *testing.T) {
+		testCases := []struct {
+			c1 pxl.Color
+			c2 pxl.Color
+			e  float64
+		}{{c1: pxl.Gray8(0x00), c2: pxl.Gray8(0xff), e: 1},
+			{c1: pxl.OKLab{L: 0.5, A: 0.1, B: 0, Alpha: 1}, c2: pxl.OKLab{L: 0.5, A: 0, B: 0.1, Alpha: 1}, e: math.Sqrt2 / 10},
+			{c1: pxl.RGBA32{R: 0xff, G: 0x00, B: 0x00, A: 0xff}, c2: pxl.Gray8(0x00), e: 0.678771}}
+		for _, testCase := range testCases {
+			t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+				assert.InDelta(t, testCase.e, pxl.DeltaEOK(testCase.c1, testCase.c2), 1e-5)
+			})
+		}
+	})
+}
+
+func BenchmarkDeltaE(b *testing.B) {
+	c1 := pxl.RGBA32{R: 0x12, G: 0x34, B: 0x56, A: 0xff}
+	c2 := pxl.RGBA32{R: 0x65, G: 0x43, B: 0x21, A: 0xff}
+	b.Run("DeltaE76()", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			pxl.DeltaE76(c1, c2)
+		}
+	})
+	b.Run("DeltaE94()", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			pxl.DeltaE94(c1, c2)
+		}
+	})
+	b.Run("DeltaE2000()", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			pxl.DeltaE2000(c1, c2)
+		}
+	})
+	b.Run("DeltaEOK()", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			pxl.DeltaEOK(c1, c2)
+		}
+	})
+}
@@ -0,0 +1,196 @@
+package pxl
+
+import "image"
+
+// A Layer is an image, or a group of layers, stacked within a [Document].
+type Layer[T Color] struct {
+	// Image holds the layer's pixels. It is nil for groups.
+	Image Image[T]
+	// Layers holds the layers of a group, from bottom to top. Their offsets are relative to the group.
+	Layers []*Layer[T]
+	// Offset translates the layer, so that its pixel at p is drawn at p.Add(Offset).
+	Offset image.Point
+	// Opacity scales the alpha of the layer, within [0, 1].
+	Opacity float64
+	// Mode is the blend mode used to mix the layer with the layers below.
+	Mode BlendMode
+	// Visible reports whether the layer is drawn.
+	Visible bool
+	// Mask, if not nil, scales the alpha of the layer by the alpha of the mask pixel at the same position.
+	// The mask is translated by Offset, like the layer.
+	Mask image.Image
+}
+
+// Returns a new visible, fully opaque layer that draws the image translated by offset.
+func NewLayer[T Color](img Image[T], offset image.Point) *Layer[T] {
+	return &Layer[T]{Image: img, Offset: offset, Opacity: 1, Mode: Normal, Visible: true}
+}
+
+// Returns a new visible, fully opaque group of layers, from bottom to top.
+// The layers of a group are composited together before being blended with the layers below the group.
+func NewGroup[T Color](layers ...*Layer[T]) *Layer[T] {
+	return &Layer[T]{Layers: layers, Opacity: 1, Mode: Normal, Visible: true}
+}
+
+// Returns the bounds of the layer, relative to its parent.
+func (l *Layer[T]) bounds() image.Rectangle {
+	if l.Image != nil {
+		return l.Image.Bounds().Add(l.Offset)
+	}
+	var r image.Rectangle
+	for _, c := range l.Layers {
+		r = r.Union(c.bounds())
+	}
+	return r.Add(l.Offset)
+}
+
+// A Document is a stack of layers that flattens into a single image.
+// The flattened image is cached, and only the regions that changed since the
+// last flattening are composited again. Changes to layers must therefore be
+// reported with [Document.Update] or [Document.Invalidate].
+type Document[T Color] struct {
+	rect   image.Rectangle
+	layers []*Layer[T]
+	flat   *Grid[T]
+	dirty  image.Rectangle
+}
+
+// Returns a new Document with the given bounds and no layers.
+func NewDocument[T Color](r image.Rectangle) *Document[T] {
+	return &Document[T]{rect: r, dirty: r}
+}
+
+// Returns the bounds of the document.
+func (d *Document[T]) Bounds() image.Rectangle {
+	return d.rect
+}
+
+// Returns the layers of the document, from bottom to top.
+// The returned slice must not be modified.
+func (d *Document[T]) Layers() []*Layer[T] {
+	return d.layers
+}
+
+// Adds the layer on top of the document.
+func (d *Document[T]) Add(l *Layer[T]) {
+	d.Insert(len(d.layers), l)
+}
+
+// Inserts the layer at index i of the document, where 0 is the bottom.
+func (d *Document[T]) Insert(i int, l *Layer[T]) {
+	d.layers = append(d.layers[:i], append([]*Layer[T]{l}, d.layers[i:]...)...)
+	d.Invalidate(l.bounds())
+}
+
+// Removes the layer from the top level of the document, and reports whether it was found.
+func (d *Document[T]) Remove(l *Layer[T]) bool {
+	for i, c := range d.layers {
+		if c == l {
+			d.layers = append(d.layers[:i], d.layers[i+1:]...)
+			d.Invalidate(l.bounds())
+			return true
+		}
+	}
+	return false
+}
+
+// Calls f to modify the layer, which may be nested within groups,
+// and marks the regions covered by the layer before and after the change as dirty.
+func (d *Document[T]) Update(l *Layer[T], f func(l *Layer[T])) {
+	origin, ok := find(d.layers, l, image.Point{})
+	if !ok {
+		f(l)
+		d.Invalidate(d.rect)
+		return
+	}
+	d.Invalidate(l.bounds().Add(origin))
+	f(l)
+	d.Invalidate(l.bounds().Add(origin))
+}
+
+// Marks the region as dirty, so that it is composited again by the next call to [Document.Flatten].
+func (d *Document[T]) Invalidate(r image.Rectangle) {
+	d.dirty = d.dirty.Union(r.Intersect(d.rect))
+}
+
+// Returns the document flattened into a single image, compositing the dirty regions again.
+// The returned image is owned by the document, and is updated by later calls to Flatten.
+// Pixels not covered by any layer are transparent.
+func (d *Document[T]) Flatten() Image[T] {
+	if d.flat == nil {
+		d.flat = NewImage[T](d.rect)
+		d.dirty = d.rect
+	}
+	if d.dirty.Empty() {
+		return d.flat
+	}
+	c := newCanvas(d.dirty)
+	drawLayers(c, d.layers, image.Point{})
+	for y := c.rect.Min.Y; y < c.rect.Max.Y; y++ {
+		for x := c.rect.Min.X; x < c.rect.Max.X; x++ {
+			d.flat.Set(x, y, fromWide[T](c.pix[c.offset(x, y)]))
+		}
+	}
+	d.dirty = image.Rectangle{}
+	return d.flat
+}
+
+// Returns the position of the origin of the layer's parent, if the layer is found within the layers.
+func find[T Color](layers []*Layer[T], l *Layer[T], origin image.Point) (image.Point, bool) {
+	for _, c := range layers {
+		if c == l {
+			return origin, true
+		}
+		if p, ok := find(c.Layers, l, origin.Add(c.Offset)); ok {
+			return p, true
+		}
+	}
+	return image.Point{}, false
+}
+
+// A canvas is a region of wide colors on which layers are composited.
+type canvas struct {
+	pix  []wide
+	rect image.Rectangle
+}
+
+// Returns a new transparent canvas with the given bounds.
+func newCanvas(r image.Rectangle) *canvas {
+	return &canvas{pix: make([]wide, r.Dx()*r.Dy()), rect: r}
+}
+
+// Returns the index of the pixel at (x, y).
+func (c *canvas) offset(x, y int) int {
+	return (y-c.rect.Min.Y)*c.rect.Dx() + (x - c.rect.Min.X)
+}
+
+// Composites the layers onto the canvas, from bottom to top, with the origin of their parent at origin.
+func drawLayers[T Color](c *canvas, layers []*Layer[T], origin image.Point) {
+	for _, l := range layers {
+		if !l.Visible || !(l.Opacity > 0) {
+			continue
+		}
+		o := origin.Add(l.Offset)
+		r := l.bounds().Add(origin).Intersect(c.rect)
+		if r.Empty() {
+			continue
+		}
+		src := func(x, y int) wide { return wideOf(l.Image.At(x, y)) }
+		if l.Image == nil {
+			// Groups are composited in isolation, then blended as a single layer.
+			g := newCanvas(r)
+			drawLayers(g, l.Layers, o)
+			src = func(x, y int) wide { return g.pix[g.offset(x+o.X, y+o.Y)] }
+		}
+		for y := r.Min.Y; y < r.Max.Y; y++ {
+			for x := r.Min.X; x < r.Max.X; x++ {
+				opacity := l.Opacity
+				if l.Mask != nil {
+					opacity *= float64(maskAt(l.Mask, x-o.X, y-o.Y)) / wideMax
+				}
+				i := c.offset(x, y)
+				c.pix[i] = l.Mode.blend(c.pix[i], src(x-o.X, y-o.Y), opacity)
+			}
+		}
+	}
+}
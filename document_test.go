@@ -0,0 +1,125 @@
+package pxl_test
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"pxl"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Returns a new image with the given bounds, filled with c.
+func filled(r image.Rectangle, c pxl.RGBA32) *pxl.Grid[pxl.RGBA32] {
+	img := pxl.NewImage[pxl.RGBA32](r)
+	for i := range img.Pix {
+		img.Pix[i] = c
+	}
+	return img
+}
+
+func TestDocument(t *testing.T) {
+	t.Parallel()
+	red := pxl.RGBA32{R: 0xff, G: 0x00, B: 0x00, A: 0xff}
+	blue := pxl.RGBA32{R: 0x00, G: 0x00, B: 0xff, A: 0xff}
+	t.Run("flattens an empty document to transparent pixels", func(t *testing.T) {
+		d := pxl.NewDocument[pxl.RGBA32](image.Rect(0, 0, 2, 2))
+		img := d.Flatten()
+		assert.Equal(t, image.Rect(0, 0, 2, 2), img.Bounds())
+		assert.Equal(t, pxl.RGBA32{}, img.Get(1, 1))
+	})
+	t.Run("stacks layers with their offsets", func(t *testing.T) {
+		d := pxl.NewDocument[pxl.RGBA32](image.Rect(0, 0, 3, 1))
+		d.Add(pxl.NewLayer[pxl.RGBA32](filled(image.Rect(0, 0, 2, 1), red), image.Pt(0, 0)))
+		d.Add(pxl.NewLayer[pxl.RGBA32](filled(image.Rect(0, 0, 2, 1), blue), image.Pt(1, 0)))
+		img := d.Flatten()
+		assert.Equal(t, red, img.Get(0, 0))
+		assert.Equal(t, blue, img.Get(1, 0))
+		assert.Equal(t, blue, img.Get(2, 0))
+	})
+	t.Run("applies opacity, blend mode and visibility", func(t *testing.T) {
+		d := pxl.NewDocument[pxl.RGBA32](image.Rect(0, 0, 1, 1))
+		d.Add(pxl.NewLayer[pxl.RGBA32](filled(image.Rect(0, 0, 1, 1), pxl.RGBA32{R: 0xcc, G: 0x33, B: 0x66, A: 0xff}), image.Point{}))
+		top := pxl.NewLayer[pxl.RGBA32](filled(image.Rect(0, 0, 1, 1), pxl.RGBA32{R: 0x33, G: 0x99, B: 0xff, A: 0xff}), image.Point{})
+		top.Mode = pxl.Multiply
+		top.Opacity = 0.5
+		d.Add(top)
+		assert.Equal(t, pxl.RGBA32{R: 0x7a, G: 0x29, B: 0x66, A: 0xff}, d.Flatten().Get(0, 0))
+		d.Update(top, func(l *pxl.Layer[pxl.RGBA32]) { l.Visible = false })
+		assert.Equal(t, pxl.RGBA32{R: 0xcc, G: 0x33, B: 0x66, A: 0xff}, d.Flatten().Get(0, 0))
+		d.Update(top, func(l *pxl.Layer[pxl.RGBA32]) { l.Visible, l.Opacity = true, math.NaN() })
+		assert.Equal(t, pxl.RGBA32{R: 0xcc, G: 0x33, B: 0x66, A: 0xff}, d.Flatten().Get(0, 0))
+	})
+	t.Run("applies masks", func(t *testing.T) {
+		d := pxl.NewDocument[pxl.RGBA32](image.Rect(0, 0, 2, 1))
+		d.Add(pxl.NewLayer[pxl.RGBA32](filled(image.Rect(0, 0, 2, 1), blue), image.Point{}))
+		l := pxl.NewLayer[pxl.RGBA32](filled(image.Rect(0, 0, 2, 1), red), image.Pt(1, 0))
+		mask := image.NewAlpha(image.Rect(0, 0, 2, 1))
+		mask.SetAlpha(0, 0, color.Alpha{A: 0x00})
+		l.Mask = mask
+		d.Add(l)
+		assert.Equal(t, blue, d.Flatten().Get(1, 0))
+		d.Update(l, func(l *pxl.Layer[pxl.RGBA32]) { mask.SetAlpha(0, 0, color.Alpha{A: 0xff}) })
+		assert.Equal(t, red, d.Flatten().Get(1, 0))
+	})
+	t.Run("composites groups in isolation", func(t *testing.T) {
+		d := pxl.NewDocument[pxl.RGBA32](image.Rect(0, 0, 3, 1))
+		d.Add(pxl.NewLayer[pxl.RGBA32](filled(image.Rect(0, 0, 3, 1), blue), image.Point{}))
+		inner := pxl.NewLayer[pxl.RGBA32](filled(image.Rect(0, 0, 1, 1), red), image.Pt(1, 0))
+		g := pxl.NewGroup(pxl.NewLayer[pxl.RGBA32](filled(image.Rect(0, 0, 1, 1), red), image.Point{}), inner)
+		g.Offset = image.Pt(1, 0)
+		g.Opacity = 0.5
+		d.Add(g)
+		img := d.Flatten()
+		assert.Equal(t, blue, img.Get(0, 0))
+		assert.Equal(t, pxl.RGBA32{R: 0x80, G: 0x00, B: 0x80, A: 0xff}, img.Get(1, 0))
+		assert.Equal(t, pxl.RGBA32{R: 0x80, G: 0x00, B: 0x80, A: 0xff}, img.Get(2, 0))
+		d.Update(inner, func(l *pxl.Layer[pxl.RGBA32]) { l.Offset = image.Pt(5, 0) })
+		assert.Equal(t, blue, d.Flatten().Get(2, 0))
+	})
+	t.Run("only composites dirty regions", func(t *testing.T) {
+		d := pxl.NewDocument[pxl.RGBA32](image.Rect(0, 0, 2, 1))
+		img := filled(image.Rect(0, 0, 2, 1), red)
+		d.Add(pxl.NewLayer[pxl.RGBA32](img, image.Point{}))
+		d.Flatten()
+		img.Set(0, 0, blue)
+		img.Set(1, 0, blue)
+		assert.Equal(t, red, d.Flatten().Get(0, 0))
+		d.Invalidate(image.Rect(1, 0, 2, 1))
+		flat := d.Flatten()
+		assert.Equal(t, red, flat.Get(0, 0))
+		assert.Equal(t, blue, flat.Get(1, 0))
+	})
+	t.Run("removes layers", func(t *testing.T) {
+		d := pxl.NewDocument[pxl.RGBA32](image.Rect(0, 0, 1, 1))
+		l := pxl.NewLayer[pxl.RGBA32](filled(image.Rect(0, 0, 1, 1), red), image.Point{})
+		d.Add(l)
+		d.Insert(0, pxl.NewLayer[pxl.RGBA32](filled(image.Rect(0, 0, 1, 1), blue), image.Point{}))
+		assert.Equal(t, red, d.Flatten().Get(0, 0))
+		assert.True(t, d.Remove(l))
+		assert.False(t, d.Remove(l))
+		assert.Len(t, d.Layers(), 1)
+		assert.Equal(t, blue, d.Flatten().Get(0, 0))
+	})
+}
+
+func BenchmarkDocument(b *testing.B) {
+	d := pxl.NewDocument[pxl.RGBA32](image.Rect(0, 0, 256, 256))
+	for i := 0; i < 4; i++ {
+		d.Add(pxl.NewLayer[pxl.RGBA32](filled(image.Rect(0, 0, 128, 128), pxl.RGBA32{R: 0xff, A: 0x80}), image.Pt(32*i, 32*i)))
+	}
+	l := d.Layers()[0]
+	b.Run("Flatten()", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			d.Invalidate(d.Bounds())
+			d.Flatten()
+		}
+	})
+	b.Run("Update()", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			d.Update(l, func(l *pxl.Layer[pxl.RGBA32]) { l.Offset.X = i % 8 })
+			d.Flatten()
+		}
+	})
+}
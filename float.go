@@ -0,0 +1,112 @@
+package pxl
+
+// An RGBAF32 is a high dynamic range color represented by linear-light sRGB channels,
+// each stored as a 32-bit floating-point number.
+// R, G and B are proportional to the emitted light: 1 is the sRGB reference white,
+// and values above 1 or below 0 represent highlights and colors outside of the sRGB gamut.
+// A ranges within [0, 1]. RGBAF32 is not alpha-premultiplied.
+//
+// RGBA() and Hex() clamp the channels within [0, 1] and encode them with the sRGB transfer function.
+type RGBAF32 struct {
+	R, G, B, A float32
+}
+
+// Returns the alpha-premultiplied red, green, blue and alpha values
+// for the color. Each value ranges within [0, 0xffff], but is represented
+// by a uint32 so that multiplying by a blend factor up to 0xffff will not
+// overflow.
+//
+// An alpha-premultiplied color component c has been scaled by alpha (a),
+// so has valid values 0 <= c <= a.
+func (c RGBAF32) RGBA() (r, g, b, a uint32) {
+	return wideOf(c).rgba()
+}
+
+// Returns the hexadecimal code representing the RGBA color,
+// with 16 bits per channel.
+func (c RGBAF32) Hex() string {
+	return convert[RGBA64](c).Hex()
+}
+
+// An RGBAF64 is a high dynamic range color represented by linear-light sRGB channels,
+// each stored as a 64-bit floating-point number.
+// R, G and B are proportional to the emitted light: 1 is the sRGB reference white,
+// and values above 1 or below 0 represent highlights and colors outside of the sRGB gamut.
+// A ranges within [0, 1]. RGBAF64 is not alpha-premultiplied.
+//
+// RGBA() and Hex() clamp the channels within [0, 1] and encode them with the sRGB transfer function.
+type RGBAF64 struct {
+	R, G, B, A float64
+}
+
+// Returns the alpha-premultiplied red, green, blue and alpha values
+// for the color. Each value ranges within [0, 0xffff], but is represented
+// by a uint32 so that multiplying by a blend factor up to 0xffff will not
+// overflow.
+//
+// An alpha-premultiplied color component c has been scaled by alpha (a),
+// so has valid values 0 <= c <= a.
+func (c RGBAF64) RGBA() (r, g, b, a uint32) {
+	return wideOf(c).rgba()
+}
+
+// Returns the hexadecimal code representing the RGBA color,
+// with 16 bits per channel.
+func (c RGBAF64) Hex() string {
+	return convert[RGBA64](c).Hex()
+}
+
+// A GrayF32 is an opaque high dynamic range gray represented by its linear-light luminance,
+// stored as a 32-bit floating-point number. 1 is the luminance of the sRGB reference white,
+// and values above 1 represent highlights.
+// Colors are converted into GrayF32 by their relative luminance, after being composited over black.
+//
+// RGBA() and Hex() clamp the luminance within [0, 1] and encode it with the sRGB transfer function.
+type GrayF32 float32
+
+// Returns the alpha-premultiplied red, green, blue and alpha values
+// for the color. Each value ranges within [0, 0xffff], but is represented
+// by a uint32 so that multiplying by a blend factor up to 0xffff will not
+// overflow.
+//
+// An alpha-premultiplied color component c has been scaled by alpha (a),
+// so has valid values 0 <= c <= a.
+func (c GrayF32) RGBA() (r, g, b, a uint32) {
+	return wideOf(c).rgba()
+}
+
+// Returns the hexadecimal code representing the RGBA color,
+// with 16 bits per channel.
+func (c GrayF32) Hex() string {
+	return convert[RGBA64](c).Hex()
+}
+
+// A GrayF64 is an opaque high dynamic range gray represented by its linear-light luminance,
+// stored as a 64-bit floating-point number. 1 is the luminance of the sRGB reference white,
+// and values above 1 represent highlights.
+// Colors are converted into GrayF64 by their relative luminance, after being composited over black.
+//
+// RGBA() and Hex() clamp the luminance within [0, 1] and encode it with the sRGB transfer function.
+type GrayF64 float64
+
+// Returns the alpha-premultiplied red, green, blue and alpha values
+// for the color. Each value ranges within [0, 0xffff], but is represented
+// by a uint32 so that multiplying by a blend factor up to 0xffff will not
+// overflow.
+//
+// An alpha-premultiplied color component c has been scaled by alpha (a),
+// so has valid values 0 <= c <= a.
+func (c GrayF64) RGBA() (r, g, b, a uint32) {
+	return wideOf(c).rgba()
+}
+
+// Returns the hexadecimal code representing the RGBA color,
+// with 16 bits per channel.
+func (c GrayF64) Hex() string {
+	return convert[RGBA64](c).Hex()
+}
+
+// Returns the relative luminance of the linear color, after compositing it over black.
+func (l linear) luminance() float64 {
+	return (0.2126*l.r + 0.7152*l.g + 0.0722*l.b) * l.a
+}
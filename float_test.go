@@ -0,0 +1,166 @@
+package pxl_test
+
+import (
+	"fmt"
+	"math"
+	"pxl"
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRGBAF32(t *testing.T) {
+	t.Parallel()
+	t.Run("implements the pxl color interface", func(t *testing.T) {
+		var v any = pxl.RGBAF32{}
+		_, ok := v.(pxl.Color)
+		assert.True(t, ok)
+	})
+	t.Run("does not exceed the expected number of bytes", func(t *testing.T) {
+		assert.Equal(t, 16, int(unsafe.Sizeof(pxl.RGBAF32{})))
+	})
+	t.Run("RGBA()", func(t *testing.T) {
+		t.Run("clamps and encodes the channels", func(t *testing.T) {
+			testCases := []struct {
+				c pxl.RGBAF32
+				r uint32
+				g uint32
+				b uint32
+				a uint32
+			}{{c: pxl.RGBAF32{}, r: 0x0000, g: 0x0000, b: 0x0000, a: 0x0000},
+				{c: pxl.RGBAF32{R: 1, G: 1, B: 1, A: 1}, r: 0xffff, g: 0xffff, b: 0xffff, a: 0xffff},
+				{c: pxl.RGBAF32{R: 0.214041, G: 0.0021, B: 0, A: 1}, r: 0x7fff, g: 0x06f2, b: 0x0000, a: 0xffff},
+				{c: pxl.RGBAF32{R: 16, G: -2, B: 0.214041, A: 0.5}, r: 0x8000, g: 0x0000, b: 0x4000, a: 0x8000},
+				{c: pxl.RGBAF32{R: float32(math.NaN()), G: float32(math.Inf(1)), B: 1, A: 2}, r: 0x0000, g: 0xffff, b: 0xffff, a: 0xffff}}
+			for _, testCase := range testCases {
+				t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+					r, g, b, a := testCase.c.RGBA()
+					assert.InDelta(t, testCase.r, r, 1)
+					assert.InDelta(t, testCase.g, g, 1)
+					assert.InDelta(t, testCase.b, b, 1)
+					assert.InDelta(t, testCase.a, a, 1)
+				})
+			}
+		})
+	})
+	t.Run("Hex()", func(t *testing.T) {
+		assert.Equal(t, "ffff00000000ffff", pxl.RGBAF32{R: 4, G: -1, B: 0, A: 1}.Hex())
+	})
+	t.Run("Convert()", func(t *testing.T) {
+		t.Run("decodes the sRGB transfer function", func(t *testing.T) {
+			c := pxl.Convert[pxl.RGBAF32](pxl.RGBA32{R: 0xff, G: 0x80, B: 0x00, A: 0x80})
+			assert.InDelta(t, 1, c.R, 1e-6)
+			assert.InDelta(t, 0.215861, c.G, 1e-6)
+			assert.InDelta(t, 0, c.B, 1e-6)
+			assert.InDelta(t, 0.501961, c.A, 1e-6)
+		})
+		t.Run("keeps values outside of the sRGB gamut", func(t *testing.T) {
+			c := pxl.Convert[pxl.RGBAF64](pxl.OKLab{L: 0.9, A: -0.3, B: 0.1, Alpha: 1})
+			assert.Less(t, c.R, 0.0)
+			lab := pxl.Convert[pxl.OKLab](c)
+			assert.InDelta(t, 0.9, lab.L, 1e-9)
+			assert.InDelta(t, -0.3, lab.A, 1e-9)
+			assert.InDelta(t, 0.1, lab.B, 1e-9)
+		})
+		t.Run("keeps values above the reference white", func(t *testing.T) {
+			c := pxl.RGBAF64{R: 4, G: 2, B: 1, A: 1}
+			assert.Equal(t, c, pxl.Convert[pxl.RGBAF64](pxl.Convert[pxl.RGBAF32](c)))
+			assert.Equal(t, pxl.RGBA32{R: 0xff, G: 0xff, B: 0xff, A: 0xff}, pxl.Convert[pxl.RGBA32](c))
+		})
+	})
+}
+
+func TestRGBAF64(t *testing.T) {
+	t.Parallel()
+	t.Run("does not exceed the expected number of bytes", func(t *testing.T) {
+		assert.Equal(t, 32, int(unsafe.Sizeof(pxl.RGBAF64{})))
+	})
+	t.Run("round-trips 16-bit colors", func(t *testing.T) {
+		testCases := []pxl.RGBA64{{},
+			{R: 0xffff, G: 0xffff, B: 0xffff, A: 0xffff},
+			{R: 0x1234, G: 0x9abc, B: 0x0001, A: 0x8000}}
+		for _, testCase := range testCases {
+			t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+				assert.Equal(t, testCase, pxl.Convert[pxl.RGBA64](pxl.Convert[pxl.RGBAF64](testCase)))
+			})
+		}
+	})
+	t.Run("ParseHex() parses the codes produced by Hex()", func(t *testing.T) {
+		c := pxl.Convert[pxl.RGBAF64](pxl.RGBA64{R: 0x1234, G: 0x9abc, B: 0x0001, A: 0x8000})
+		p, err := pxl.ParseHex[pxl.RGBAF64](c.Hex())
+		assert.NoError(t, err)
+		assert.Equal(t, c, p)
+	})
+}
+
+func TestGrayF32(t *testing.T) {
+	t.Parallel()
+	t.Run("implements the pxl color interface", func(t *testing.T) {
+		var v any = pxl.GrayF32(0)
+		_, ok := v.(pxl.Color)
+		assert.True(t, ok)
+	})
+	t.Run("does not exceed the expected number of bytes", func(t *testing.T) {
+		assert.Equal(t, 4, int(unsafe.Sizeof(pxl.GrayF32(0))))
+	})
+	t.Run("RGBA()", func(t *testing.T) {
+		t.Run("clamps and encodes the luminance", func(t *testing.T) {
+			testCases := []struct {
+				c pxl.GrayF32
+				y uint32
+			}{{c: 0, y: 0x0000},
+				{c: 0.214041, y: 0x7fff},
+				{c: 1, y: 0xffff},
+				{c: 8, y: 0xffff},
+				{c: -1, y: 0x0000}}
+			for _, testCase := range testCases {
+				t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+					r, g, b, a := testCase.c.RGBA()
+					assert.InDelta(t, testCase.y, r, 1)
+					assert.Equal(t, r, g)
+					assert.Equal(t, r, b)
+					assert.Equal(t, uint32(0xffff), a)
+				})
+			}
+		})
+	})
+	t.Run("Convert()", func(t *testing.T) {
+		t.Run("returns the relative luminance composited over black", func(t *testing.T) {
+			testCases := []struct {
+				c pxl.Color
+				y float64
+			}{{c: pxl.RGBA32{R: 0xff, A: 0xff}, y: 0.2126},
+				{c: pxl.RGBA32{G: 0xff, A: 0xff}, y: 0.7152},
+				{c: pxl.RGBAF64{R: 2, G: 2, B: 2, A: 0.5}, y: 1},
+				{c: pxl.Gray8(0xff), y: 1}}
+			for _, testCase := range testCases {
+				t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+					assert.InDelta(t, testCase.y, float64(pxl.Convert[pxl.GrayF32](testCase.c)), 1e-6)
+					assert.InDelta(t, testCase.y, float64(pxl.Convert[pxl.GrayF64](testCase.c)), 1e-9)
+				})
+			}
+		})
+		t.Run("expands to equal linear channels", func(t *testing.T) {
+			assert.Equal(t, pxl.RGBAF64{R: 3, G: 3, B: 3, A: 1}, pxl.Convert[pxl.RGBAF64](pxl.GrayF64(3)))
+		})
+	})
+	t.Run("Hex()", func(t *testing.T) {
+		assert.Equal(t, "ffffffffffffffff", pxl.GrayF64(2).Hex())
+	})
+}
+
+func BenchmarkRGBAF32(b *testing.B) {
+	b.Run("RGBA", func(b *testing.B) {
+		c := pxl.RGBAF32{R: 0.5, G: 2, B: 0.01, A: 1}
+		for i := 0; i < b.N; i++ {
+			_, _, _, _ = c.RGBA()
+		}
+	})
+	b.Run("Convert", func(b *testing.B) {
+		c := pxl.RGBA32{R: 0xaa, G: 0x55, B: 0x11, A: 0xff}
+		for i := 0; i < b.N; i++ {
+			_ = pxl.Convert[pxl.RGBAF32](c)
+		}
+	})
+}
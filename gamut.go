@@ -0,0 +1,227 @@
+package pxl
+
+import (
+	"math"
+	"strconv"
+)
+
+// A GamutMapping is a strategy that brings colors outside of the gamut of a color space within it,
+// such as colors of a wider color space, or [Lab], [OKLCh] and float colors that exceed sRGB.
+type GamutMapping int
+
+// Gamut mappings.
+const (
+	// Clip clamps each channel within the gamut. It is the fastest mapping, and the one used by [Convert],
+	// but may shift the hue and lightness of colors far outside of the gamut.
+	Clip GamutMapping = iota
+	// ReduceChroma reduces the chroma of colors in OKLCh, keeping their lightness and hue, until they are
+	// within a just noticeable difference of the gamut, as specified by CSS Color Module Level 4.
+	ReduceChroma
+	// Compress maps the chromas beyond a knee of the gamut's chroma, in OKLCh, smoothly within the gamut,
+	// so that out-of-gamut colors keep their gradations rather than piling up on its boundary.
+	// When converting between color spaces, it also desaturates the colors of the source gamut that lie
+	// near the boundary, unlike the other mappings. Otherwise, colors within the gamut are left untouched.
+	Compress
+)
+
+var gamutMappingNames = [...]string{"Clip", "ReduceChroma", "Compress"}
+
+// Returns the name of the gamut mapping.
+func (m GamutMapping) String() string {
+	if m < 0 || int(m) >= len(gamutMappingNames) {
+		return "GamutMapping(" + strconv.Itoa(int(m)) + ")"
+	}
+	return gamutMappingNames[m]
+}
+
+// Returns whether the color lies within the gamut of the color space.
+// Colors of types that cannot represent colors outside of sRGB, such as [RGBA32], lie within the gamut
+// of any color space that contains sRGB.
+func InGamut(c Color, s *ColorSpace) bool {
+	l, ok := linearOf(c)
+	if !ok {
+		l = wideOf(c).linear()
+	}
+	return gamutOf(s).contains(l)
+}
+
+// Returns the color converted into T, with the given gamut mapping if it lies outside of the sRGB gamut.
+// Unlike [Convert], the result lies within the sRGB gamut even if T can represent colors outside of it.
+func MapGamut[T Color](c Color, m GamutMapping) T {
+	l, ok := linearOf(c)
+	if !ok {
+		return convert[T](c)
+	}
+	g := gamutOf(SRGBSpace)
+	l = g.clip(m.apply(l, g, nil))
+	if v, ok := fromLinear[T](l); ok {
+		return v
+	}
+	return fromWide[T](l.wide())
+}
+
+// The tolerance of gamut boundaries, in linear light, that absorbs rounding errors.
+const gamutEpsilon = 1e-6
+
+// The fraction of the gamut's chroma below which [Compress] leaves colors untouched.
+const compressKnee = 0.75
+
+// A gamut is the gamut of a color space, as seen from linear-light sRGB.
+type gamut struct {
+	// fromSRGB and toSRGB transform colors between linear-light sRGB and the linear channels of the color space.
+	fromSRGB, toSRGB *matrix3
+	// chromas, if not nil, caches the results of maxChroma by OKLCh lightness and hue.
+	chromas map[[2]float64]float64
+}
+
+// The number of boundary chromas that a gamut caches before discarding them.
+const maxCachedChromas = 1 << 14
+
+// Returns a copy of the gamut that caches its boundary chromas, for mapping many colors.
+func (g gamut) cached() gamut {
+	g.chromas = make(map[[2]float64]float64)
+	return g
+}
+
+// The identity matrix, which transforms linear-light sRGB into itself.
+var identity3 = &matrix3{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}}
+
+// Returns the gamut of the color space.
+func gamutOf(s *ColorSpace) gamut {
+	if s == SRGBSpace {
+		return gamut{fromSRGB: identity3, toSRGB: identity3}
+	}
+	m := spaceTransform(SRGBSpace, s)
+	return gamut{fromSRGB: m, toSRGB: m.inverse()}
+}
+
+// Returns whether the linear color lies within the gamut.
+func (g gamut) contains(l linear) bool {
+	r, gg, b := g.fromSRGB.mul(l.r, l.g, l.b)
+	return r >= -gamutEpsilon && r <= 1+gamutEpsilon &&
+		gg >= -gamutEpsilon && gg <= 1+gamutEpsilon &&
+		b >= -gamutEpsilon && b <= 1+gamutEpsilon
+}
+
+// Returns the linear color with its channels in the color space clamped within [0, 1].
+func (g gamut) clip(l linear) linear {
+	r, gg, b := g.fromSRGB.mul(l.r, l.g, l.b)
+	r, gg, b = g.toSRGB.mul(min(max(r, 0), 1), min(max(gg, 0), 1), min(max(b, 0), 1))
+	return linear{r: r, g: gg, b: b, a: l.a}
+}
+
+// Returns the largest chroma of the gamut at the given OKLCh lightness and hue.
+func (g gamut) maxChroma(l, h float64) float64 {
+	if g.chromas == nil {
+		return g.searchChroma(l, h)
+	}
+	key := [2]float64{l, h}
+	if c, ok := g.chromas[key]; ok {
+		return c
+	}
+	if len(g.chromas) >= maxCachedChromas {
+		clear(g.chromas)
+	}
+	c := g.searchChroma(l, h)
+	g.chromas[key] = c
+	return c
+}
+
+// Returns the largest chroma of the gamut at the given OKLCh lightness and hue, found by a binary search.
+func (g gamut) searchChroma(l, h float64) float64 {
+	lo, hi := 0.0, 0.5
+	for hi < 8 && g.contains(OKLCh{L: l, C: hi, H: h, Alpha: 1}.okLab().linear()) {
+		lo, hi = hi, 2*hi
+	}
+	for hi-lo > 1e-6 {
+		c := (lo + hi) / 2
+		if g.contains(OKLCh{L: l, C: c, H: h, Alpha: 1}.okLab().linear()) {
+			lo = c
+		} else {
+			hi = c
+		}
+	}
+	return lo
+}
+
+// Returns the linear color mapped within the gamut dst. The gamut src, if not nil, is the gamut
+// of the color space that the color comes from; a nil src stands for an unbounded source.
+// The result may lie outside of dst by a negligible amount, so that it should be clipped.
+func (m GamutMapping) apply(l linear, dst gamut, src *gamut) linear {
+	if m == Clip || (m == ReduceChroma && dst.contains(l)) {
+		return dst.clip(l)
+	}
+	lch := l.okLab().okLCh()
+	switch {
+	case lch.L >= 1:
+		return linear{r: 1, g: 1, b: 1, a: l.a}
+	case lch.L <= 0:
+		return linear{a: l.a}
+	}
+	if m == ReduceChroma {
+		return reduceChroma(lch, dst)
+	}
+	return compress(lch, dst, src)
+}
+
+// Returns the OKLCh color with its chroma reduced within the gamut, following the binary search
+// of CSS Color Module Level 4, which stops within a just noticeable difference of the gamut's boundary.
+func reduceChroma(lch OKLCh, g gamut) linear {
+	const jnd, epsilon = 0.02, 0.0001
+	current := lch.okLab().linear()
+	clipped := g.clip(current)
+	if okDistance(clipped, current) < jnd {
+		return clipped
+	}
+	lo, hi, loInGamut := 0.0, lch.C, true
+	for hi-lo > epsilon {
+		lch.C = (lo + hi) / 2
+		current = lch.okLab().linear()
+		if loInGamut && g.contains(current) {
+			lo = lch.C
+			continue
+		}
+		clipped = g.clip(current)
+		if e := okDistance(clipped, current); e < jnd {
+			if jnd-e < epsilon {
+				return clipped
+			}
+			loInGamut = false
+			lo = lch.C
+		} else {
+			hi = lch.C
+		}
+	}
+	return clipped
+}
+
+// Returns the OKLCh color with its chroma compressed within the gamut dst.
+// Chromas between the knee and the boundary of src are mapped linearly between the knee and the boundary of dst.
+// Without src, or for colors beyond the boundary of src, such as float colors, colors within dst are left
+// untouched, and the chromas of the others are mapped asymptotically between the knee and the boundary of dst.
+func compress(lch OKLCh, dst gamut, src *gamut) linear {
+	maxDst := dst.maxChroma(lch.L, lch.H)
+	knee := compressKnee * maxDst
+	if lch.C <= knee {
+		return lch.okLab().linear()
+	}
+	l := lch.okLab().linear()
+	if src != nil && src.contains(l) {
+		if maxSrc := src.maxChroma(lch.L, lch.H); maxSrc > maxDst {
+			lch.C = min(knee+(lch.C-knee)*(maxDst-knee)/(maxSrc-knee), maxDst)
+			return lch.okLab().linear()
+		}
+		return l
+	}
+	if dst.contains(l) {
+		return l
+	}
+	lch.C = knee + (maxDst-knee)*math.Tanh((lch.C-knee)/(maxDst-knee))
+	return lch.okLab().linear()
+}
+
+// Returns the Oklab distance between the linear colors, as [DeltaEOK] does.
+func okDistance(l1, l2 linear) float64 {
+	o1, o2 := l1.okLab(), l2.okLab()
+	return math.Sqrt(sq(o1.L-o2.L) + sq(o1.A-o2.A) + sq(o1.B-o2.B))
+}
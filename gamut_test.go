@@ -0,0 +1,223 @@
+package pxl_test
+
+import (
+	"fmt"
+	"image"
+	"pxl"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGamutMapping(t *testing.T) {
+	t.Parallel()
+	t.Run("String()", func(t *testing.T) {
+		assert.Equal(t, "ReduceChroma", pxl.ReduceChroma.String())
+		assert.Equal(t, "GamutMapping(3)", pxl.GamutMapping(3).String())
+	})
+}
+
+func TestInGamut(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		c     pxl.Color
+		srgb  bool
+		p3    bool
+		wider bool
+	}{{c: pxl.RGBA32{R: 0xff, A: 0xff}, srgb: true, p3: true, wider: true},
+		{c: pxl.OKLCh{L: 1, Alpha: 1}, srgb: true, p3: true, wider: true},
+		{c: pxl.Lab{L: 50, A: 20, B: -30, Alpha: 1}, srgb: true, p3: true, wider: true},
+		{c: pxl.RGBAF64{R: 1.2249401762805596, G: -0.04205695470968801, B: -0.019637554590334446, A: 1}, srgb: false, p3: true, wider: false},
+		{c: pxl.OKLCh{L: 0.7, C: 0.3, H: 150, Alpha: 1}, srgb: false, p3: false, wider: true},
+		{c: pxl.OKLCh{L: 0.7, C: 0.4, H: 30, Alpha: 1}, srgb: false, p3: false, wider: false},
+		{c: pxl.RGBAF64{R: 2, G: 2, B: 2, A: 1}, srgb: false, p3: false, wider: false}}
+	for _, testCase := range testCases {
+		t.Run(fmt.Sprintf("%+v", testCase.c), func(t *testing.T) {
+			assert.Equal(t, testCase.srgb, pxl.InGamut(testCase.c, pxl.SRGBSpace))
+			assert.Equal(t, testCase.p3, pxl.InGamut(testCase.c, pxl.DisplayP3))
+			assert.Equal(t, testCase.wider, pxl.InGamut(testCase.c, pxl.Rec2020))
+		})
+	}
+}
+
+func TestMapGamut(t *testing.T) {
+	t.Parallel()
+	mappings := []pxl.GamutMapping{pxl.Clip, pxl.ReduceChroma, pxl.Compress}
+	outside := []pxl.Color{pxl.OKLCh{L: 0.7, C: 0.4, H: 30, Alpha: 1},
+		pxl.OKLCh{L: 0.5, C: 0.3, H: 260, Alpha: 0.5},
+		pxl.Lab{L: 90, A: -100, B: 40, Alpha: 1},
+		pxl.RGBAF64{R: 1.2249, G: -0.0420, B: -0.0197, A: 1}}
+	t.Run("returns colors within the sRGB gamut", func(t *testing.T) {
+		for _, m := range mappings {
+			for _, c := range outside {
+				t.Run(fmt.Sprintf("%v/%+v", m, c), func(t *testing.T) {
+					assert.True(t, pxl.InGamut(pxl.MapGamut[pxl.OKLCh](c, m), pxl.SRGBSpace))
+					assert.True(t, pxl.InGamut(pxl.MapGamut[pxl.RGBAF32](c, m), pxl.SRGBSpace))
+				})
+			}
+		}
+	})
+	t.Run("matches Convert() when clipping", func(t *testing.T) {
+		for _, c := range outside {
+			t.Run(fmt.Sprintf("%+v", c), func(t *testing.T) {
+				assert.Equal(t, pxl.Convert[pxl.RGBA64](c), pxl.MapGamut[pxl.RGBA64](c, pxl.Clip))
+			})
+		}
+	})
+	t.Run("keeps the lightness and hue when reducing chroma", func(t *testing.T) {
+		for _, m := range []pxl.GamutMapping{pxl.ReduceChroma, pxl.Compress} {
+			for _, c := range outside {
+				t.Run(fmt.Sprintf("%v/%+v", m, c), func(t *testing.T) {
+					e := pxl.Convert[pxl.OKLCh](c)
+					v := pxl.MapGamut[pxl.OKLCh](c, m)
+					assert.Less(t, v.C, e.C)
+					assert.Less(t, pxl.DeltaEOK(v, pxl.OKLCh{L: e.L, C: v.C, H: e.H, Alpha: e.Alpha}), 0.02)
+					assert.Equal(t, e.Alpha, v.Alpha)
+				})
+			}
+		}
+	})
+	t.Run("leaves colors within the gamut untouched", func(t *testing.T) {
+		testCases := []pxl.Color{pxl.OKLCh{L: 0.6, C: 0.05, H: 120, Alpha: 1},
+			pxl.RGBA32{R: 0xff, G: 0x80, A: 0xff},
+			pxl.RGBAF64{R: 0.2, G: 0.4, B: 0.6, A: 0.5},
+			pxl.RGBAF64{R: 1, A: 1},
+			pxl.OKLCh{L: 0.7, C: 0.19, H: 145, Alpha: 1}}
+		for _, m := range mappings {
+			for _, c := range testCases {
+				t.Run(fmt.Sprintf("%v/%+v", m, c), func(t *testing.T) {
+					assert.Equal(t, pxl.Convert[pxl.RGBA64](c), pxl.MapGamut[pxl.RGBA64](c, m))
+				})
+			}
+		}
+	})
+	t.Run("maps lightnesses outside of [0, 1] to white and black", func(t *testing.T) {
+		for _, m := range []pxl.GamutMapping{pxl.ReduceChroma, pxl.Compress} {
+			t.Run(m.String(), func(t *testing.T) {
+				assert.Equal(t, pxl.RGBA32{R: 0xff, G: 0xff, B: 0xff, A: 0xff}, pxl.MapGamut[pxl.RGBA32](pxl.OKLCh{L: 1.2, C: 0.2, H: 90, Alpha: 1}, m))
+				assert.Equal(t, pxl.RGBA32{A: 0xff}, pxl.MapGamut[pxl.RGBA32](pxl.OKLCh{L: -0.1, C: 0.2, H: 90, Alpha: 1}, m))
+			})
+		}
+	})
+	t.Run("compresses out-of-gamut chromas monotonically", func(t *testing.T) {
+		prev := 0.0
+		for c := 0.0; c <= 0.6; c += 0.02 {
+			lch := pxl.OKLCh{L: 0.6, C: c, H: 200, Alpha: 1}
+			if pxl.InGamut(lch, pxl.SRGBSpace) {
+				continue
+			}
+			v := pxl.MapGamut[pxl.OKLCh](lch, pxl.Compress)
+			assert.GreaterOrEqual(t, v.C, prev-1e-9, c)
+			assert.Less(t, v.C, c)
+			prev = v.C
+		}
+		assert.Positive(t, prev)
+	})
+}
+
+func TestConvertSpaceGamutMapping(t *testing.T) {
+	t.Parallel()
+	reds := []pxl.RGBA64{{R: 0xffff, A: 0xffff},
+		{R: 0xffff, G: 0x1000, B: 0x1000, A: 0xffff},
+		{R: 0xffff, G: 0x2000, B: 0x2000, A: 0xffff}}
+	t.Run("clipping merges distinct colors", func(t *testing.T) {
+		assert.Equal(t, pxl.ConvertSpace(reds[0], pxl.DisplayP3, pxl.SRGBSpace, pxl.Clip), pxl.ConvertSpace(reds[2], pxl.DisplayP3, pxl.SRGBSpace, pxl.Clip))
+	})
+	for _, m := range []pxl.GamutMapping{pxl.ReduceChroma, pxl.Compress} {
+		t.Run(m.String(), func(t *testing.T) {
+			t.Run("keeps distinct colors distinct", func(t *testing.T) {
+				prev := pxl.RGBA64{}
+				for _, c := range reds {
+					v := pxl.ConvertSpace(c, pxl.DisplayP3, pxl.SRGBSpace, m)
+					assert.NotEqual(t, prev, v)
+					assert.True(t, pxl.InGamut(v, pxl.SRGBSpace))
+					prev = v
+				}
+			})
+			t.Run("leaves colors within both gamuts untouched", func(t *testing.T) {
+				c := pxl.RGBA64{R: 0xffff, G: 0x8000, A: 0xffff}
+				assert.Equal(t, pxl.ConvertSpace(c, pxl.SRGBSpace, pxl.DisplayP3, pxl.Clip), pxl.ConvertSpace(c, pxl.SRGBSpace, pxl.DisplayP3, m))
+			})
+			t.Run("is applied to images", func(t *testing.T) {
+				img := pxl.NewImage[pxl.RGBA64](image.Rect(0, 0, 3, 1))
+				copy(img.Pix, reds)
+				dst := pxl.ConvertImageSpace[pxl.RGBA64](img, pxl.DisplayP3, pxl.SRGBSpace, m)
+				for x, c := range reds {
+					assert.Equal(t, pxl.ConvertSpace(c, pxl.DisplayP3, pxl.SRGBSpace, m), dst.Get(x, 0))
+				}
+			})
+		})
+	}
+}
+
+func TestConvertSpaceGamutMappingLinear(t *testing.T) {
+	t.Parallel()
+	// Reds of Display P3 beyond the sRGB red, as linear sRGB.
+	reds := []pxl.RGBAF64{{R: 1.2249, G: -0.0421, B: -0.0196, A: 1},
+		{R: 1.15, G: -0.03, B: -0.015, A: 1},
+		{R: 1.08, G: -0.015, B: -0.01, A: 0.5}}
+	inGamut := func(t *testing.T, c pxl.RGBAF64) {
+		for _, v := range []float64{c.R, c.G, c.B} {
+			assert.GreaterOrEqual(t, v, -1e-6, c)
+			assert.LessOrEqual(t, v, 1+1e-6, c)
+		}
+	}
+	for _, m := range []pxl.GamutMapping{pxl.Clip, pxl.ReduceChroma, pxl.Compress} {
+		t.Run(m.String(), func(t *testing.T) {
+			t.Run("returns colors within the gamut", func(t *testing.T) {
+				for _, c := range reds {
+					v := pxl.ConvertSpace(c, pxl.SRGBSpace, pxl.AdobeRGB, m)
+					inGamut(t, v)
+					assert.Equal(t, c.A, v.A)
+				}
+			})
+			t.Run("is applied to images", func(t *testing.T) {
+				img := pxl.NewImage[pxl.RGBAF64](image.Rect(0, 0, 3, 2))
+				copy(img.Pix, reds)
+				copy(img.Pix[3:], reds)
+				dst := pxl.ConvertImageSpace[pxl.RGBAF64](img, pxl.SRGBSpace, pxl.AdobeRGB, m)
+				for i, c := range img.Pix {
+					assert.Equal(t, pxl.ConvertSpace(c, pxl.SRGBSpace, pxl.AdobeRGB, m), dst.Get(i%3, i/3))
+				}
+			})
+		})
+	}
+	for _, m := range []pxl.GamutMapping{pxl.ReduceChroma, pxl.Compress} {
+		t.Run(m.String(), func(t *testing.T) {
+			t.Run("keeps distinct colors distinct", func(t *testing.T) {
+				prev := pxl.RGBAF64{}
+				for _, c := range reds[:2] {
+					v := pxl.ConvertSpace(c, pxl.SRGBSpace, pxl.AdobeRGB, m)
+					assert.NotEqual(t, prev, v)
+					prev = v
+				}
+			})
+			t.Run("keeps the hue", func(t *testing.T) {
+				c := pxl.ConvertSpace(reds[0], pxl.SRGBSpace, pxl.AdobeRGB, m)
+				v := pxl.ConvertSpace(c, pxl.AdobeRGB, pxl.SRGBSpace, pxl.Clip)
+				assert.InDelta(t, pxl.Convert[pxl.OKLCh](reds[0]).H, pxl.Convert[pxl.OKLCh](v).H, 2)
+			})
+		})
+	}
+	t.Run("maps HDR colors by their chromaticity", func(t *testing.T) {
+		c := pxl.RGBAF64{R: 4 * reds[0].R, G: 4 * reds[0].G, B: 4 * reds[0].B, A: 1}
+		v := pxl.ConvertSpace(c, pxl.SRGBSpace, pxl.AdobeRGB, pxl.ReduceChroma)
+		c = pxl.RGBAF64{R: 2 * reds[0].R, G: 2 * reds[0].G, B: 2 * reds[0].B, A: 1}
+		e := pxl.ConvertSpace(c, pxl.SRGBSpace, pxl.AdobeRGB, pxl.ReduceChroma)
+		assert.Greater(t, e.R, 1.0)
+		assert.InDelta(t, 2*e.R, v.R, 1e-9)
+		assert.InDelta(t, 2*e.G, v.G, 1e-9)
+		assert.InDelta(t, 2*e.B, v.B, 1e-9)
+	})
+}
+
+func BenchmarkMapGamut(b *testing.B) {
+	c := pxl.OKLCh{L: 0.7, C: 0.4, H: 30, Alpha: 1}
+	for _, m := range []pxl.GamutMapping{pxl.Clip, pxl.ReduceChroma, pxl.Compress} {
+		b.Run(m.String(), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = pxl.MapGamut[pxl.RGBA32](c, m)
+			}
+		})
+	}
+}
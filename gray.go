@@ -98,3 +98,115 @@ func (c Gray64) RGBA() (r, g, b, a uint32) {
 func (c Gray64) Hex() string {
 	return fmt.Sprintf("%016x%016x%016xffffffffffffffff", c, c, c)
 }
+
+// A GrayA16 is a 16-bit color represented by a gray level and an alpha channel.
+// Each channel is represented by 8 bits.
+// GrayA16 is not alpha-premultiplied.
+type GrayA16 struct {
+	Y, A uint8
+}
+
+// Returns the alpha-premultiplied red, green, blue and alpha values
+// for the color. Each value ranges within [0, 0xffff], but is represented
+// by a uint32 so that multiplying by a blend factor up to 0xffff will not
+// overflow.
+//
+// An alpha-premultiplied color component c has been scaled by alpha (a),
+// so has valid values 0 <= c <= a.
+func (c GrayA16) RGBA() (r, g, b, a uint32) {
+	a = uint32(c.A) * 0x00000101
+	gray := uint32(c.Y) * a / 0x000000ff
+	r = gray
+	g = gray
+	b = gray
+	return
+}
+
+// Returns the hexadecimal code representing the RGBA color.
+func (c GrayA16) Hex() string {
+	return fmt.Sprintf("%02x%02x%02x%02x", c.Y, c.Y, c.Y, c.A)
+}
+
+// A GrayA32 is a 32-bit color represented by a gray level and an alpha channel.
+// Each channel is represented by 16 bits.
+// GrayA32 is not alpha-premultiplied.
+type GrayA32 struct {
+	Y, A uint16
+}
+
+// Returns the alpha-premultiplied red, green, blue and alpha values
+// for the color. Each value ranges within [0, 0xffff], but is represented
+// by a uint32 so that multiplying by a blend factor up to 0xffff will not
+// overflow.
+//
+// An alpha-premultiplied color component c has been scaled by alpha (a),
+// so has valid values 0 <= c <= a.
+func (c GrayA32) RGBA() (r, g, b, a uint32) {
+	a = uint32(c.A)
+	gray := uint32(c.Y) * a / 0x0000ffff
+	r = gray
+	g = gray
+	b = gray
+	return
+}
+
+// Returns the hexadecimal code representing the RGBA color.
+func (c GrayA32) Hex() string {
+	return fmt.Sprintf("%04x%04x%04x%04x", c.Y, c.Y, c.Y, c.A)
+}
+
+// A GrayA64 is a 64-bit color represented by a gray level and an alpha channel.
+// Each channel is represented by 32 bits.
+// GrayA64 is not alpha-premultiplied.
+type GrayA64 struct {
+	Y, A uint32
+}
+
+// Returns the alpha-premultiplied red, green, blue and alpha values
+// for the color. Each value ranges within [0, 0xffff], but is represented
+// by a uint32 so that multiplying by a blend factor up to 0xffff will not
+// overflow.
+//
+// An alpha-premultiplied color component c has been scaled by alpha (a),
+// so has valid values 0 <= c <= a.
+func (c GrayA64) RGBA() (r, g, b, a uint32) {
+	a = c.A >> 16
+	gray := c.Y >> 16 * a / 0x0000ffff
+	r = gray
+	g = gray
+	b = gray
+	return
+}
+
+// Returns the hexadecimal code representing the RGBA color.
+func (c GrayA64) Hex() string {
+	return fmt.Sprintf("%08x%08x%08x%08x", c.Y, c.Y, c.Y, c.A)
+}
+
+// A GrayA128 is a 128-bit color represented by a gray level and an alpha channel.
+// Each channel is represented by 64 bits.
+// GrayA128 is not alpha-premultiplied.
+type GrayA128 struct {
+	Y, A uint64
+}
+
+// Returns the alpha-premultiplied red, green, blue and alpha values
+// for the color. Each value ranges within [0, 0xffff], but is represented
+// by a uint32 so that multiplying by a blend factor up to 0xffff will not
+// overflow.
+//
+// An alpha-premultiplied color component c has been scaled by alpha (a),
+// so has valid values 0 <= c <= a.
+func (c GrayA128) RGBA() (r, g, b, a uint32) {
+	a = uint32(c.A >> 48)
+	gray := uint32(c.Y>>48) * a / 0x0000ffff
+	r = gray
+	g = gray
+	b = gray
+	return
+}
+
+// Returns the hexadecimal code representing the RGBA color.
+func (c GrayA128) Hex() string {
+	return fmt.Sprintf("%016x%016x%016x%016x", c.Y, c.Y, c.Y, c.A)
+}
@@ -278,3 +278,314 @@ func BenchmarkGray64(b *testing.B) {
 		}
 	})
 }
+
+func TestGrayA16(t *testing.T) {
+	t.Parallel()
+	t.Run("implements the pxl color interface", func(t *testing.T) {
+		var v any = pxl.GrayA16{}
+		_, ok := v.(pxl.Color)
+		assert.True(t, ok)
+	})
+	t.Run("implements the std color interface", func(t *testing.T) {
+		var v any = pxl.GrayA16{}
+		_, ok := v.(color.Color)
+		assert.True(t, ok)
+	})
+	t.Run("does not exceed the expected number of bytes", func(t *testing.T) {
+		assert.Equal(t, 2, int(unsafe.Sizeof(pxl.GrayA16{})))
+	})
+	t.Run("RGBA()", func(t *testing.T) {
+		t.Run("returns the correct values", func(t *testing.T) {
+			testCases := []struct {
+				c pxl.GrayA16
+				r uint32
+				g uint32
+				b uint32
+				a uint32
+			}{{c: pxl.GrayA16{Y: 0x00, A: 0x00}, r: 0x00000000, g: 0x00000000, b: 0x00000000, a: 0x00000000},
+				{c: pxl.GrayA16{Y: 0xff, A: 0xff}, r: 0x0000ffff, g: 0x0000ffff, b: 0x0000ffff, a: 0x0000ffff},
+				{c: pxl.GrayA16{Y: 0x9b, A: 0x80}, r: 0x00004e1b, g: 0x00004e1b, b: 0x00004e1b, a: 0x00008080}}
+			for _, testCase := range testCases {
+				t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+					r, g, b, a := testCase.c.RGBA()
+					assert.Equal(t, testCase.r, r)
+					assert.Equal(t, testCase.g, g)
+					assert.Equal(t, testCase.b, b)
+					assert.Equal(t, testCase.a, a)
+				})
+			}
+		})
+	})
+	t.Run("Hex()", func(t *testing.T) {
+		t.Run("returns the correct value", func(t *testing.T) {
+			testCases := []struct {
+				c   pxl.GrayA16
+				hex string
+			}{{c: pxl.GrayA16{Y: 0x00, A: 0x00}, hex: "00000000"},
+				{c: pxl.GrayA16{Y: 0xff, A: 0xff}, hex: "ffffffff"},
+				{c: pxl.GrayA16{Y: 0x9b, A: 0x80}, hex: "9b9b9b80"}}
+			for _, testCase := range testCases {
+				t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+					assert.Equal(t, testCase.hex, testCase.c.Hex())
+					c, err := pxl.ParseHex[pxl.GrayA16](testCase.hex)
+					assert.NoError(t, err)
+					assert.Equal(t, testCase.c, c)
+				})
+			}
+		})
+	})
+}
+
+func BenchmarkGrayA16(b *testing.B) {
+	b.Run("RGBA()", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			pxl.GrayA16{Y: 0x9b, A: 0x80}.RGBA()
+		}
+	})
+	b.Run("Hex()", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			pxl.GrayA16{Y: 0x9b, A: 0x80}.Hex()
+		}
+	})
+}
+
+func TestGrayA32(t *testing.T) {
+	t.Parallel()
+	t.Run("implements the pxl color interface", func(t *testing.T) {
+		var v any = pxl.GrayA32{}
+		_, ok := v.(pxl.Color)
+		assert.True(t, ok)
+	})
+	t.Run("implements the std color interface", func(t *testing.T) {
+		var v any = pxl.GrayA32{}
+		_, ok := v.(color.Color)
+		assert.True(t, ok)
+	})
+	t.Run("does not exceed the expected number of bytes", func(t *testing.T) {
+		assert.Equal(t, 4, int(unsafe.Sizeof(pxl.GrayA32{})))
+	})
+	t.Run("RGBA()", func(t *testing.T) {
+		t.Run("returns the correct values", func(t *testing.T) {
+			testCases := []struct {
+				c pxl.GrayA32
+				r uint32
+				g uint32
+				b uint32
+				a uint32
+			}{{c: pxl.GrayA32{Y: 0x0000, A: 0x0000}, r: 0x00000000, g: 0x00000000, b: 0x00000000, a: 0x00000000},
+				{c: pxl.GrayA32{Y: 0xffff, A: 0xffff}, r: 0x0000ffff, g: 0x0000ffff, b: 0x0000ffff, a: 0x0000ffff},
+				{c: pxl.GrayA32{Y: 0x9b9b, A: 0x8000}, r: 0x00004dcd, g: 0x00004dcd, b: 0x00004dcd, a: 0x00008000}}
+			for _, testCase := range testCases {
+				t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+					r, g, b, a := testCase.c.RGBA()
+					assert.Equal(t, testCase.r, r)
+					assert.Equal(t, testCase.g, g)
+					assert.Equal(t, testCase.b, b)
+					assert.Equal(t, testCase.a, a)
+				})
+			}
+		})
+	})
+	t.Run("Hex()", func(t *testing.T) {
+		t.Run("returns the correct value", func(t *testing.T) {
+			testCases := []struct {
+				c   pxl.GrayA32
+				hex string
+			}{{c: pxl.GrayA32{Y: 0x0000, A: 0x0000}, hex: "0000000000000000"},
+				{c: pxl.GrayA32{Y: 0xffff, A: 0xffff}, hex: "ffffffffffffffff"},
+				{c: pxl.GrayA32{Y: 0x9b9b, A: 0x8000}, hex: "9b9b9b9b9b9b8000"}}
+			for _, testCase := range testCases {
+				t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+					assert.Equal(t, testCase.hex, testCase.c.Hex())
+					c, err := pxl.ParseHex[pxl.GrayA32](testCase.hex)
+					assert.NoError(t, err)
+					assert.Equal(t, testCase.c, c)
+				})
+			}
+		})
+	})
+}
+
+func BenchmarkGrayA32(b *testing.B) {
+	b.Run("RGBA()", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			pxl.GrayA32{Y: 0x9b9b, A: 0x8000}.RGBA()
+		}
+	})
+	b.Run("Hex()", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			pxl.GrayA32{Y: 0x9b9b, A: 0x8000}.Hex()
+		}
+	})
+}
+
+func TestGrayA64(t *testing.T) {
+	t.Parallel()
+	t.Run("implements the pxl color interface", func(t *testing.T) {
+		var v any = pxl.GrayA64{}
+		_, ok := v.(pxl.Color)
+		assert.True(t, ok)
+	})
+	t.Run("implements the std color interface", func(t *testing.T) {
+		var v any = pxl.GrayA64{}
+		_, ok := v.(color.Color)
+		assert.True(t, ok)
+	})
+	t.Run("does not exceed the expected number of bytes", func(t *testing.T) {
+		assert.Equal(t, 8, int(unsafe.Sizeof(pxl.GrayA64{})))
+	})
+	t.Run("RGBA()", func(t *testing.T) {
+		t.Run("returns the correct values", func(t *testing.T) {
+			testCases := []struct {
+				c pxl.GrayA64
+				r uint32
+				g uint32
+				b uint32
+				a uint32
+			}{{c: pxl.GrayA64{Y: 0x00000000, A: 0x00000000}, r: 0x00000000, g: 0x00000000, b: 0x00000000, a: 0x00000000},
+				{c: pxl.GrayA64{Y: 0xffffffff, A: 0xffffffff}, r: 0x0000ffff, g: 0x0000ffff, b: 0x0000ffff, a: 0x0000ffff},
+				{c: pxl.GrayA64{Y: 0x9b9b9b9b, A: 0x80000000}, r: 0x00004dcd, g: 0x00004dcd, b: 0x00004dcd, a: 0x00008000}}
+			for _, testCase := range testCases {
+				t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+					r, g, b, a := testCase.c.RGBA()
+					assert.Equal(t, testCase.r, r)
+					assert.Equal(t, testCase.g, g)
+					assert.Equal(t, testCase.b, b)
+					assert.Equal(t, testCase.a, a)
+				})
+			}
+		})
+	})
+	t.Run("Hex()", func(t *testing.T) {
+		t.Run("returns the correct value", func(t *testing.T) {
+			testCases := []struct {
+				c   pxl.GrayA64
+				hex string
+			}{{c: pxl.GrayA64{Y: 0x00000000, A: 0x00000000}, hex: "00000000000000000000000000000000"},
+				{c: pxl.GrayA64{Y: 0xffffffff, A: 0xffffffff}, hex: "ffffffffffffffffffffffffffffffff"},
+				{c: pxl.GrayA64{Y: 0x9b9b9b9b, A: 0x80000000}, hex: "9b9b9b9b9b9b9b9b9b9b9b9b80000000"}}
+			for _, testCase := range testCases {
+				t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+					assert.Equal(t, testCase.hex, testCase.c.Hex())
+					c, err := pxl.ParseHex[pxl.GrayA64](testCase.hex)
+					assert.NoError(t, err)
+					assert.Equal(t, testCase.c, c)
+				})
+			}
+		})
+	})
+}
+
+func BenchmarkGrayA64(b *testing.B) {
+	b.Run("RGBA()", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			pxl.GrayA64{Y: 0x9b9b9b9b, A: 0x80000000}.RGBA()
+		}
+	})
+	b.Run("Hex()", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			pxl.GrayA64{Y: 0x9b9b9b9b, A: 0x80000000}.Hex()
+		}
+	})
+}
+
+func TestGrayA128(t *testing.T) {
+	t.Parallel()
+	t.Run("implements the pxl color interface", func(t *testing.T) {
+		var v any = pxl.GrayA128{}
+		_, ok := v.(pxl.Color)
+		assert.True(t, ok)
+	})
+	t.Run("implements the std color interface", func(t *testing.T) {
+		var v any = pxl.GrayA128{}
+		_, ok := v.(color.Color)
+		assert.True(t, ok)
+	})
+	t.Run("does not exceed the expected number of bytes", func(t *testing.T) {
+		assert.Equal(t, 16, int(unsafe.Sizeof(pxl.GrayA128{})))
+	})
+	t.Run("RGBA()", func(t *testing.T) {
+		t.Run("returns the correct values", func(t *testing.T) {
+			testCases := []struct {
+				c pxl.GrayA128
+				r uint32
+				g uint32
+				b uint32
+				a uint32
+			}{{c: pxl.GrayA128{Y: 0x0000000000000000, A: 0x0000000000000000}, r: 0x00000000, g: 0x00000000, b: 0x00000000, a: 0x00000000},
+				{c: pxl.GrayA128{Y: 0xffffffffffffffff, A: 0xffffffffffffffff}, r: 0x0000ffff, g: 0x0000ffff, b: 0x0000ffff, a: 0x0000ffff},
+				{c: pxl.GrayA128{Y: 0x9b9b9b9b9b9b9b9b, A: 0x8000000000000000}, r: 0x00004dcd, g: 0x00004dcd, b: 0x00004dcd, a: 0x00008000}}
+			for _, testCase := range testCases {
+				t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+					r, g, b, a := testCase.c.RGBA()
+					assert.Equal(t, testCase.r, r)
+					assert.Equal(t, testCase.g, g)
+					assert.Equal(t, testCase.b, b)
+					assert.Equal(t, testCase.a, a)
+				})
+			}
+		})
+	})
+	t.Run("Hex()", func(t *testing.T) {
+		t.Run("returns the correct value", func(t *testing.T) {
+			testCases := []struct {
+				c   pxl.GrayA128
+				hex string
+			}{{c: pxl.GrayA128{Y: 0x0000000000000000, A: 0x0000000000000000}, hex: "0000000000000000000000000000000000000000000000000000000000000000"},
+				{c: pxl.GrayA128{Y: 0xffffffffffffffff, A: 0xffffffffffffffff}, hex: "ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"},
+				{c: pxl.GrayA128{Y: 0x9b9b9b9b9b9b9b9b, A: 0x8000000000000000}, hex: "9b9b9b9b9b9b9b9b9b9b9b9b9b9b9b9b9b9b9b9b9b9b9b9b8000000000000000"}}
+			for _, testCase := range testCases {
+				t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+					assert.Equal(t, testCase.hex, testCase.c.Hex())
+					c, err := pxl.ParseHex[pxl.GrayA128](testCase.hex)
+					assert.NoError(t, err)
+					assert.Equal(t, testCase.c, c)
+				})
+			}
+		})
+	})
+}
+
+func BenchmarkGrayA128(b *testing.B) {
+	b.Run("RGBA()", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			pxl.GrayA128{Y: 0x9b9b9b9b9b9b9b9b, A: 0x8000000000000000}.RGBA()
+		}
+	})
+	b.Run("Hex()", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			pxl.GrayA128{Y: 0x9b9b9b9b9b9b9b9b, A: 0x8000000000000000}.Hex()
+		}
+	})
+}
+
+func TestGrayAModels(t *testing.T) {
+	t.Parallel()
+	t.Run("convert to the correct values", func(t *testing.T) {
+		assert.Equal(t, pxl.GrayA16{Y: 0xff, A: 0x80}, pxl.GrayA16Model.Convert(pxl.RGBA32{R: 0xff, G: 0xff, B: 0xff, A: 0x80}))
+		assert.Equal(t, pxl.GrayA16{Y: 0x4c, A: 0x40}, pxl.GrayA16Model.Convert(pxl.RGBA32{R: 0xff, G: 0x00, B: 0x00, A: 0x40}))
+		assert.Equal(t, pxl.GrayA32{Y: 0x8080, A: 0xffff}, pxl.GrayA32Model.Convert(pxl.Gray8(0x80)))
+		assert.Equal(t, pxl.GrayA64{Y: 0x80808080, A: 0x40404040}, pxl.GrayA64Model.Convert(pxl.GrayA16{Y: 0x80, A: 0x40}))
+		assert.Equal(t, pxl.GrayA128{}, pxl.GrayA128Model.Convert(color.Transparent))
+	})
+	t.Run("round-trip every GrayA16 color through RGBA32", func(t *testing.T) {
+		for y := 0; y <= 0xff; y++ {
+			for a := 0; a <= 0xff; a++ {
+				c := pxl.GrayA16{Y: uint8(y), A: uint8(a)}
+				if c != pxl.Convert[pxl.GrayA16](pxl.Convert[pxl.RGBA32](c)) {
+					assert.Fail(t, "round trip failed", "%+v", c)
+				}
+			}
+		}
+	})
+	t.Run("are consistent with the opaque gray types", func(t *testing.T) {
+		for y := 0; y <= 0xffff; y += 0x0101 {
+			assert.Equal(t, pxl.Gray16(y).Hex(), pxl.GrayA32{Y: uint16(y), A: 0xffff}.Hex())
+			assert.Equal(t, pxl.GrayA32{Y: uint16(y), A: 0xffff}, pxl.Convert[pxl.GrayA32](pxl.Gray16(y)))
+		}
+	})
+	t.Run("reject codes whose color channels differ", func(t *testing.T) {
+		_, err := pxl.ParseHex[pxl.GrayA16]("9b9b9a80")
+		assert.ErrorIs(t, err, pxl.ErrHexValue)
+	})
+}
@@ -0,0 +1,89 @@
+package pxl
+
+import (
+	"image"
+	"image/color"
+)
+
+// A Grid is an in-memory image whose pixels are stored densely, in row-major
+// order, as a slice of colors of type T.
+// It implements both [Image] and the standard library's [image.Image].
+type Grid[T Color] struct {
+	// Pix holds the image's pixels. The pixel at (x, y) is stored at
+	// Pix[(y-Rect.Min.Y)*Stride + (x-Rect.Min.X)].
+	Pix []T
+	// Stride is the Pix stride (in pixels, not bytes) between vertically
+	// adjacent pixels.
+	Stride int
+	// Rect is the image's bounds.
+	Rect image.Rectangle
+}
+
+// Returns a new Grid with the given bounds.
+// Every pixel is initialized to the zero value of T.
+func NewImage[T Color](r image.Rectangle) *Grid[T] {
+	w, h := r.Dx(), r.Dy()
+	return &Grid[T]{
+		Pix:    make([]T, w*h),
+		Stride: w,
+		Rect:   r,
+	}
+}
+
+// Returns the domain for which At can return non-zero color.
+func (p *Grid[T]) Bounds() image.Rectangle {
+	return p.Rect
+}
+
+// Returns the color model of the image.
+func (p *Grid[T]) ColorModel() color.Model {
+	return modelFor[T]()
+}
+
+// Returns the color of the pixel at (x, y).
+// Pixels outside of the image's bounds are the zero value of T.
+func (p *Grid[T]) At(x, y int) color.Color {
+	return p.Get(x, y)
+}
+
+// Returns the color of the pixel at (x, y).
+// Pixels outside of the image's bounds are the zero value of T.
+func (p *Grid[T]) Get(x, y int) T {
+	if !(image.Point{x, y}.In(p.Rect)) {
+		var zero T
+		return zero
+	}
+	return p.Pix[p.PixOffset(x, y)]
+}
+
+// Sets the color of the pixel at (x, y).
+// Pixels outside of the image's bounds are left untouched.
+func (p *Grid[T]) Set(x, y int, c T) {
+	if !(image.Point{x, y}.In(p.Rect)) {
+		return
+	}
+	p.Pix[p.PixOffset(x, y)] = c
+}
+
+// Returns the index of the first element of Pix that corresponds to the pixel at (x, y).
+func (p *Grid[T]) PixOffset(x, y int) int {
+	return (y-p.Rect.Min.Y)*p.Stride + (x - p.Rect.Min.X)
+}
+
+// Returns an image representing the portion of the image visible through r.
+// The returned image shares pixels with the original image.
+func (p *Grid[T]) SubImage(r image.Rectangle) Image[T] {
+	r = r.Intersect(p.Rect)
+	// If r1 and r2 are Rectangles, r1.Intersect(r2) is not guaranteed to be inside
+	// either r1 or r2 if the intersection is empty. Without explicitly checking for
+	// this, the Pix[i:] expression below can panic.
+	if r.Empty() {
+		return &Grid[T]{}
+	}
+	i := p.PixOffset(r.Min.X, r.Min.Y)
+	return &Grid[T]{
+		Pix:    p.Pix[i:],
+		Stride: p.Stride,
+		Rect:   r,
+	}
+}
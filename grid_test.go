@@ -0,0 +1,96 @@
+package pxl_test
+
+import (
+	"fmt"
+	"image"
+	"pxl"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGrid(t *testing.T) {
+	t.Parallel()
+	t.Run("implements the pxl image interface", func(t *testing.T) {
+		var v any = pxl.NewImage[pxl.RGBA32](image.Rect(0, 0, 1, 1))
+		_, ok := v.(pxl.Image[pxl.RGBA32])
+		assert.True(t, ok)
+	})
+	t.Run("implements the std image interface", func(t *testing.T) {
+		var v any = pxl.NewImage[pxl.RGBA32](image.Rect(0, 0, 1, 1))
+		_, ok := v.(image.Image)
+		assert.True(t, ok)
+	})
+	t.Run("NewImage()", func(t *testing.T) {
+		t.Run("allocates one pixel per point", func(t *testing.T) {
+			testCases := []struct {
+				r      image.Rectangle
+				stride int
+			}{{r: image.Rect(0, 0, 0, 0), stride: 0},
+				{r: image.Rect(0, 0, 3, 2), stride: 3},
+				{r: image.Rect(-2, 5, 2, 9), stride: 4}}
+			for _, testCase := range testCases {
+				t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+					img := pxl.NewImage[pxl.Gray8](testCase.r)
+					assert.Equal(t, testCase.r, img.Bounds())
+					assert.Equal(t, testCase.stride, img.Stride)
+					assert.Len(t, img.Pix, testCase.r.Dx()*testCase.r.Dy())
+				})
+			}
+		})
+	})
+	t.Run("Get()", func(t *testing.T) {
+		t.Run("returns the color that was set", func(t *testing.T) {
+			img := pxl.NewImage[pxl.RGBA32](image.Rect(-1, -1, 2, 2))
+			c := pxl.RGBA32{R: 0xaa, G: 0x55, B: 0xaa, A: 0xff}
+			img.Set(1, 0, c)
+			assert.Equal(t, c, img.Get(1, 0))
+			assert.Equal(t, c, img.At(1, 0))
+			assert.Equal(t, c, img.Pix[img.PixOffset(1, 0)])
+			assert.Equal(t, pxl.RGBA32{}, img.Get(0, 0))
+		})
+		t.Run("returns the zero value out of bounds", func(t *testing.T) {
+			img := pxl.NewImage[pxl.Gray8](image.Rect(0, 0, 2, 2))
+			img.Set(2, 2, pxl.Gray8(0xff))
+			assert.Equal(t, pxl.Gray8(0x00), img.Get(2, 2))
+			assert.Equal(t, pxl.Gray8(0x00), img.Get(-1, 0))
+		})
+	})
+	t.Run("ColorModel()", func(t *testing.T) {
+		t.Run("leaves colors of the image's type untouched", func(t *testing.T) {
+			img := pxl.NewImage[pxl.RGBA64](image.Rect(0, 0, 1, 1))
+			c := pxl.RGBA64{R: 0x1234, G: 0x5678, B: 0x9abc, A: 0xdef0}
+			assert.Equal(t, c, img.ColorModel().Convert(c))
+		})
+	})
+	t.Run("SubImage()", func(t *testing.T) {
+		t.Run("shares pixels with the original image", func(t *testing.T) {
+			img := pxl.NewImage[pxl.Gray16](image.Rect(0, 0, 4, 4))
+			sub := img.SubImage(image.Rect(1, 1, 3, 3))
+			assert.Equal(t, image.Rect(1, 1, 3, 3), sub.Bounds())
+			sub.Set(2, 2, pxl.Gray16(0xa5af))
+			assert.Equal(t, pxl.Gray16(0xa5af), img.Get(2, 2))
+			img.Set(1, 1, pxl.Gray16(0xffff))
+			assert.Equal(t, pxl.Gray16(0xffff), sub.Get(1, 1))
+		})
+		t.Run("is clipped to the original image", func(t *testing.T) {
+			img := pxl.NewImage[pxl.Gray16](image.Rect(0, 0, 4, 4))
+			assert.Equal(t, image.Rect(2, 2, 4, 4), img.SubImage(image.Rect(2, 2, 8, 8)).Bounds())
+			assert.True(t, img.SubImage(image.Rect(5, 5, 8, 8)).Bounds().Empty())
+		})
+	})
+}
+
+func BenchmarkGrid(b *testing.B) {
+	img := pxl.NewImage[pxl.RGBA32](image.Rect(0, 0, 256, 256))
+	b.Run("Get()", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			img.Get(i&0xff, (i>>8)&0xff)
+		}
+	})
+	b.Run("Set()", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			img.Set(i&0xff, (i>>8)&0xff, pxl.RGBA32{R: uint8(i)})
+		}
+	})
+}
@@ -0,0 +1,319 @@
+package pxl
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Errors reported by [ParseHex].
+var (
+	// ErrHexLength reports that a hexadecimal code has the wrong number of digits for its color type.
+	ErrHexLength = errors.New("invalid length")
+	// ErrHexDigit reports that a hexadecimal code contains a character that is not a hexadecimal digit.
+	ErrHexDigit = errors.New("invalid hexadecimal digit")
+	// ErrHexValue reports that a hexadecimal code is well-formed, but is never produced by its color type.
+	ErrHexValue = errors.New("value not representable")
+)
+
+// A HexError records a failed attempt to parse a hexadecimal code.
+type HexError struct {
+	Type  string // the name of the color type, e.g. "RGBA32"
+	Input string // the input
+	Err   error  // the reason the parse failed (ErrHexLength, ErrHexDigit or ErrHexValue)
+}
+
+// Returns a description of the error.
+func (e *HexError) Error() string {
+	return "pxl.ParseHex[" + e.Type + "]: parsing " + strconv.Quote(e.Input) + ": " + e.Err.Error()
+}
+
+// Returns the reason the parse failed.
+func (e *HexError) Unwrap() error {
+	return e.Err
+}
+
+// Parses a hexadecimal code into a color of type T.
+// It accepts exactly the codes produced by T's Hex method, in either case,
+// optionally preceded by a '#'.
+// Errors are of type [*HexError].
+//
+// T must be one of the color types of this package.
+func ParseHex[T Color](s string) (T, error) {
+	var c T
+	digits := strings.TrimPrefix(s, "#")
+	err := parseHex(&c, digits)
+	if err != nil {
+		var zero T
+		return zero, &HexError{Type: strings.TrimPrefix(fmt.Sprintf("%T", c), "pxl."), Input: s, Err: err}
+	}
+	return c, nil
+}
+
+// Parses the hexadecimal digits into the color pointed to by p.
+func parseHex(p any, s string) error {
+	switch p := p.(type) {
+	case *RGBA8:
+		v, err := hexUints(s, 2)
+		*p = RGBA8(v[0])
+		return err
+	case *RGBA16:
+		v, err := hexUints(s, 4)
+		*p = RGBA16(v[0])
+		return err
+	case *RGB565:
+		v, err := hexUints(s, 4)
+		*p = RGB565(v[0])
+		return err
+	case *RGB555:
+		v, err := hexUints(s, 4)
+		if err == nil && v[0] > 0x7fff {
+			return ErrHexValue
+		}
+		*p = RGB555(v[0])
+		return err
+	case *ARGB1555:
+		v, err := hexUints(s, 4)
+		*p = ARGB1555(v[0])
+		return err
+	case *RGB444:
+		v, err := hexUints(s, 4)
+		if err == nil && v[0] > 0x0fff {
+			return ErrHexValue
+		}
+		*p = RGB444(v[0])
+		return err
+	case *RGBA32:
+		v, err := hexUints(s, 2, 2, 2, 2)
+		*p = RGBA32{R: uint8(v[0]), G: uint8(v[1]), B: uint8(v[2]), A: uint8(v[3])}
+		return err
+	case *RGBA64:
+		v, err := hexUints(s, 4, 4, 4, 4)
+		*p = RGBA64{R: uint16(v[0]), G: uint16(v[1]), B: uint16(v[2]), A: uint16(v[3])}
+		return err
+	case *RGBA128:
+		v, err := hexUints(s, 8, 8, 8, 8)
+		*p = RGBA128{R: uint32(v[0]), G: uint32(v[1]), B: uint32(v[2]), A: uint32(v[3])}
+		return err
+	case *RGBA256:
+		v, err := hexUints(s, 16, 16, 16, 16)
+		*p = RGBA256{R: v[0], G: v[1], B: v[2], A: v[3]}
+		return err
+	case *BGRA32:
+		v, err := hexUints(s, 2, 2, 2, 2)
+		*p = BGRA32{R: uint8(v[0]), G: uint8(v[1]), B: uint8(v[2]), A: uint8(v[3])}
+		return err
+	case *ARGB32:
+		v, err := hexUints(s, 2, 2, 2, 2)
+		*p = ARGB32{R: uint8(v[0]), G: uint8(v[1]), B: uint8(v[2]), A: uint8(v[3])}
+		return err
+	case *ABGR32:
+		v, err := hexUints(s, 2, 2, 2, 2)
+		*p = ABGR32{R: uint8(v[0]), G: uint8(v[1]), B: uint8(v[2]), A: uint8(v[3])}
+		return err
+	case *BGRA64:
+		v, err := hexUints(s, 4, 4, 4, 4)
+		*p = BGRA64{R: uint16(v[0]), G: uint16(v[1]), B: uint16(v[2]), A: uint16(v[3])}
+		return err
+	case *ARGB64:
+		v, err := hexUints(s, 4, 4, 4, 4)
+		*p = ARGB64{R: uint16(v[0]), G: uint16(v[1]), B: uint16(v[2]), A: uint16(v[3])}
+		return err
+	case *ABGR64:
+		v, err := hexUints(s, 4, 4, 4, 4)
+		*p = ABGR64{R: uint16(v[0]), G: uint16(v[1]), B: uint16(v[2]), A: uint16(v[3])}
+		return err
+	case *PRGBA32:
+		v, err := hexPremultiplied(s, 2)
+		*p = PRGBA32{R: uint8(v[0]), G: uint8(v[1]), B: uint8(v[2]), A: uint8(v[3])}
+		return err
+	case *PRGBA64:
+		v, err := hexPremultiplied(s, 4)
+		*p = PRGBA64{R: uint16(v[0]), G: uint16(v[1]), B: uint16(v[2]), A: uint16(v[3])}
+		return err
+	case *PRGBA128:
+		v, err := hexPremultiplied(s, 8)
+		*p = PRGBA128{R: uint32(v[0]), G: uint32(v[1]), B: uint32(v[2]), A: uint32(v[3])}
+		return err
+	case *PRGBA256:
+		v, err := hexPremultiplied(s, 16)
+		*p = PRGBA256{R: v[0], G: v[1], B: v[2], A: v[3]}
+		return err
+	case *CMYK32:
+		v, err := hexUints(s, 2, 2, 2, 2)
+		*p = CMYK32{C: uint8(v[0]), M: uint8(v[1]), Y: uint8(v[2]), K: uint8(v[3])}
+		return err
+	case *CMYK64:
+		v, err := hexUints(s, 4, 4, 4, 4)
+		*p = CMYK64{C: uint16(v[0]), M: uint16(v[1]), Y: uint16(v[2]), K: uint16(v[3])}
+		return err
+	case *Gray8:
+		v, err := hexGray(s, 2)
+		*p = Gray8(v)
+		return err
+	case *Gray16:
+		v, err := hexGray(s, 4)
+		*p = Gray16(v)
+		return err
+	case *Gray32:
+		v, err := hexGray(s, 8)
+		*p = Gray32(v)
+		return err
+	case *Gray64:
+		v, err := hexGray(s, 16)
+		*p = Gray64(v)
+		return err
+	case *GrayA16:
+		y, a, err := hexGrayA(s, 2)
+		*p = GrayA16{Y: uint8(y), A: uint8(a)}
+		return err
+	case *GrayA32:
+		y, a, err := hexGrayA(s, 4)
+		*p = GrayA32{Y: uint16(y), A: uint16(a)}
+		return err
+	case *GrayA64:
+		y, a, err := hexGrayA(s, 8)
+		*p = GrayA64{Y: uint32(y), A: uint32(a)}
+		return err
+	case *GrayA128:
+		y, a, err := hexGrayA(s, 16)
+		*p = GrayA128{Y: y, A: a}
+		return err
+	case *Mono:
+		y, err := hexGray(s, 2)
+		if err == nil && y != 0 && y != 0xff {
+			return ErrHexValue
+		}
+		*p = y != 0
+		return err
+	case *Alpha1:
+		a, err := hexAlpha(s, 2)
+		if err == nil && a != 0 && a != 0xff {
+			return ErrHexValue
+		}
+		*p = a != 0
+		return err
+	case *Alpha8:
+		a, err := hexAlpha(s, 2)
+		*p = Alpha8(a)
+		return err
+	case *Alpha16:
+		a, err := hexAlpha(s, 4)
+		*p = Alpha16(a)
+		return err
+	case *HSL:
+		return parseHexRGBA64(p, s)
+	case *HSV:
+		return parseHexRGBA64(p, s)
+	case *YCbCr:
+		return parseHexRGBA64(p, s)
+	case *RGBAF32:
+		return parseHexRGBA64(p, s)
+	case *RGBAF64:
+		return parseHexRGBA64(p, s)
+	case *GrayF32:
+		return parseHexRGBA64(p, s)
+	case *GrayF64:
+		return parseHexRGBA64(p, s)
+	case *Lab:
+		return parseHexRGBA64(p, s)
+	case *LCh:
+		return parseHexRGBA64(p, s)
+	case *OKLab:
+		return parseHexRGBA64(p, s)
+	case *OKLCh:
+		return parseHexRGBA64(p, s)
+	}
+	panic(fmt.Sprintf("pxl: cannot parse into %T", p))
+}
+
+// Parses the hexadecimal digits of an RGBA64 color, and converts it into the color pointed to by p.
+func parseHexRGBA64[T Color](p *T, s string) error {
+	var v RGBA64
+	err := parseHex(&v, s)
+	*p = convert[T](v)
+	return err
+}
+
+// Splits s into fields of the given numbers of hexadecimal digits,
+// and parses each field into an unsigned integer.
+func hexUints(s string, widths ...int) ([]uint64, error) {
+	n := 0
+	for _, w := range widths {
+		n += w
+	}
+	v := make([]uint64, len(widths))
+	if len(s) != n {
+		return v, ErrHexLength
+	}
+	for i, w := range widths {
+		for _, d := range []byte(s[:w]) {
+			switch {
+			case '0' <= d && d <= '9':
+				d -= '0'
+			case 'a' <= d && d <= 'f':
+				d -= 'a' - 10
+			case 'A' <= d && d <= 'F':
+				d -= 'A' - 10
+			default:
+				return make([]uint64, len(widths)), ErrHexDigit
+			}
+			v[i] = v[i]<<4 | uint64(d)
+		}
+		s = s[w:]
+	}
+	return v, nil
+}
+
+// Parses an alpha-premultiplied hexadecimal code, whose fields each have the given
+// number of digits, and whose color fields do not exceed its alpha field.
+func hexPremultiplied(s string, width int) ([]uint64, error) {
+	v, err := hexUints(s, width, width, width, width)
+	if err != nil {
+		return v, err
+	}
+	if v[0] > v[3] || v[1] > v[3] || v[2] > v[3] {
+		return make([]uint64, 4), ErrHexValue
+	}
+	return v, nil
+}
+
+// Parses a gray hexadecimal code, whose red, green and blue fields each have
+// the given number of digits and are equal, and whose alpha field is opaque.
+func hexGray(s string, width int) (uint64, error) {
+	y, a, err := hexGrayA(s, width)
+	if err != nil {
+		return 0, err
+	}
+	if a != 1<<(4*width)-1 {
+		return 0, ErrHexValue
+	}
+	return y, nil
+}
+
+// Parses an alpha hexadecimal code, whose fields each have the given number of digits,
+// and whose red, green and blue fields are white.
+func hexAlpha(s string, width int) (uint64, error) {
+	y, a, err := hexGrayA(s, width)
+	if err != nil {
+		return 0, err
+	}
+	if y != 1<<(4*width)-1 {
+		return 0, ErrHexValue
+	}
+	return a, nil
+}
+
+// Parses a gray hexadecimal code with alpha, whose red, green and blue fields
+// each have the given number of digits and are equal.
+func hexGrayA(s string, width int) (y, a uint64, err error) {
+	v, err := hexUints(s, width, width, width, width)
+	if err != nil {
+		return 0, 0, err
+	}
+	if v[0] != v[1] || v[0] != v[2] {
+		return 0, 0, ErrHexValue
+	}
+	return v[0], v[3], nil
+}
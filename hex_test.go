@@ -0,0 +1,113 @@
+package pxl_test
+
+import (
+	"errors"
+	"fmt"
+	"pxl"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseHex(t *testing.T) {
+	t.Parallel()
+	t.Run("parses the codes produced by Hex()", func(t *testing.T) {
+		testCases := []pxl.Color{
+			pxl.RGBA8(0x9b),
+			pxl.RGBA16(0xa5af),
+			pxl.RGBA32{R: 0xaa, G: 0x55, B: 0xaa, A: 0xff},
+			pxl.RGBA64{R: 0xaaaa, G: 0x5555, B: 0xaaaa, A: 0xffff},
+			pxl.RGBA128{R: 0x12345678, G: 0x9abcdef0, B: 0x0fedcba9, A: 0x87654321},
+			pxl.RGBA256{R: 0x0123456789abcdef, G: 0xfedcba9876543210, B: 0x00ff00ff00ff00ff, A: 0xff00ff00ff00ff00},
+			pxl.Gray8(0x9b),
+			pxl.Gray16(0xa5af),
+			pxl.Gray32(0xaa55aaff),
+			pxl.Gray64(0xaaaa5555aaaaffff)}
+		for _, testCase := range testCases {
+			t.Run(fmt.Sprintf("%T%+v", testCase, testCase), func(t *testing.T) {
+				var c pxl.Color
+				var err error
+				switch testCase.(type) {
+				case pxl.RGBA8:
+					c, err = pxl.ParseHex[pxl.RGBA8](testCase.Hex())
+				case pxl.RGBA16:
+					c, err = pxl.ParseHex[pxl.RGBA16](testCase.Hex())
+				case pxl.RGBA32:
+					c, err = pxl.ParseHex[pxl.RGBA32](testCase.Hex())
+				case pxl.RGBA64:
+					c, err = pxl.ParseHex[pxl.RGBA64](testCase.Hex())
+				case pxl.RGBA128:
+					c, err = pxl.ParseHex[pxl.RGBA128](testCase.Hex())
+				case pxl.RGBA256:
+					c, err = pxl.ParseHex[pxl.RGBA256](testCase.Hex())
+				case pxl.Gray8:
+					c, err = pxl.ParseHex[pxl.Gray8](testCase.Hex())
+				case pxl.Gray16:
+					c, err = pxl.ParseHex[pxl.Gray16](testCase.Hex())
+				case pxl.Gray32:
+					c, err = pxl.ParseHex[pxl.Gray32](testCase.Hex())
+				case pxl.Gray64:
+					c, err = pxl.ParseHex[pxl.Gray64]("#" + testCase.Hex())
+				}
+				assert.NoError(t, err)
+				assert.Equal(t, testCase, c)
+			})
+		}
+	})
+	t.Run("accepts a leading '#' and uppercase digits", func(t *testing.T) {
+		c, err := pxl.ParseHex[pxl.RGBA32]("#AA55aaFF")
+		assert.NoError(t, err)
+		assert.Equal(t, pxl.RGBA32{R: 0xaa, G: 0x55, B: 0xaa, A: 0xff}, c)
+	})
+	t.Run("returns typed errors for invalid codes", func(t *testing.T) {
+		testCases := []struct {
+			s   string
+			err error
+		}{{s: "", err: pxl.ErrHexLength},
+			{s: "#", err: pxl.ErrHexLength},
+			{s: "aa55aa", err: pxl.ErrHexLength},
+			{s: "##aa55aaff", err: pxl.ErrHexLength},
+			{s: "aa55aaf", err: pxl.ErrHexLength},
+			{s: "aa55aafg", err: pxl.ErrHexDigit},
+			{s: "#aa55 aff", err: pxl.ErrHexDigit},
+			{s: "+a55aaff", err: pxl.ErrHexDigit}}
+		for _, testCase := range testCases {
+			t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+				c, err := pxl.ParseHex[pxl.RGBA32](testCase.s)
+				assert.ErrorIs(t, err, testCase.err)
+				var hexErr *pxl.HexError
+				assert.True(t, errors.As(err, &hexErr))
+				assert.Equal(t, "RGBA32", hexErr.Type)
+				assert.Equal(t, testCase.s, hexErr.Input)
+				assert.Equal(t, pxl.RGBA32{}, c)
+			})
+		}
+	})
+	t.Run("rejects gray codes that Hex() never produces", func(t *testing.T) {
+		testCases := []string{"9b9b9aff", "9b9b9b80", "a5afa5afa5af0000"}
+		for _, testCase := range testCases {
+			t.Run(testCase, func(t *testing.T) {
+				var err error
+				if len(testCase) == 8 {
+					_, err = pxl.ParseHex[pxl.Gray8](testCase)
+				} else {
+					_, err = pxl.ParseHex[pxl.Gray16](testCase)
+				}
+				assert.ErrorIs(t, err, pxl.ErrHexValue)
+			})
+		}
+	})
+}
+
+func BenchmarkParseHex(b *testing.B) {
+	b.Run("RGBA32", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = pxl.ParseHex[pxl.RGBA32]("#aa55aaff")
+		}
+	})
+	b.Run("RGBA256", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = pxl.ParseHex[pxl.RGBA256]("0123456789abcdeffedcba987654321000ff00ff00ff00ffff00ff00ff00ff00")
+		}
+	})
+}
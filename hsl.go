@@ -0,0 +1,131 @@
+package pxl
+
+import "math"
+
+// An HSL is a color represented by the cylindrical HSL (hue, saturation, lightness) color model.
+// H is the hue in degrees, within [0, 360). S, L and A (alpha) range within [0, 1].
+// HSL is not alpha-premultiplied, and describes the same gamma-encoded RGB values as the RGBA types.
+type HSL struct {
+	H, S, L, A float64
+}
+
+// Returns the alpha-premultiplied red, green, blue and alpha values
+// for the color. Each value ranges within [0, 0xffff], but is represented
+// by a uint32 so that multiplying by a blend factor up to 0xffff will not
+// overflow.
+//
+// An alpha-premultiplied color component c has been scaled by alpha (a),
+// so has valid values 0 <= c <= a.
+func (c HSL) RGBA() (r, g, b, a uint32) {
+	return wideOf(c).rgba()
+}
+
+// Returns the hexadecimal code representing the RGBA color,
+// with 16 bits per channel.
+func (c HSL) Hex() string {
+	return convert[RGBA64](c).Hex()
+}
+
+// An HSV is a color represented by the cylindrical HSV (hue, saturation, value) color model.
+// H is the hue in degrees, within [0, 360). S, V and A (alpha) range within [0, 1].
+// HSV is not alpha-premultiplied, and describes the same gamma-encoded RGB values as the RGBA types.
+type HSV struct {
+	H, S, V, A float64
+}
+
+// Returns the alpha-premultiplied red, green, blue and alpha values
+// for the color. Each value ranges within [0, 0xffff], but is represented
+// by a uint32 so that multiplying by a blend factor up to 0xffff will not
+// overflow.
+//
+// An alpha-premultiplied color component c has been scaled by alpha (a),
+// so has valid values 0 <= c <= a.
+func (c HSV) RGBA() (r, g, b, a uint32) {
+	return wideOf(c).rgba()
+}
+
+// Returns the hexadecimal code representing the RGBA color,
+// with 16 bits per channel.
+func (c HSV) Hex() string {
+	return convert[RGBA64](c).Hex()
+}
+
+// Converts a color from HSL to RGB, as specified by CSS.
+// The hue is in degrees, and the saturation and lightness range within [0, 1].
+func hslToRGB(h, s, l float64) (r, g, b float64) {
+	h = normalizeHue(h)
+	s = min(max(s, 0), 1)
+	l = min(max(l, 0), 1)
+	f := func(n float64) float64 {
+		k := math.Mod(n+h/30, 12)
+		a := s * min(l, 1-l)
+		return l - a*max(-1, min(k-3, 9-k, 1))
+	}
+	return f(0), f(8), f(4)
+}
+
+// Converts a color from HSV to RGB.
+// The hue is in degrees, and the saturation and value range within [0, 1].
+func hsvToRGB(h, s, v float64) (r, g, b float64) {
+	h = normalizeHue(h)
+	s = min(max(s, 0), 1)
+	v = min(max(v, 0), 1)
+	f := func(n float64) float64 {
+		k := math.Mod(n+h/60, 6)
+		return v - v*s*max(0, min(k, 4-k, 1))
+	}
+	return f(5), f(3), f(1)
+}
+
+// Converts a color from RGB to HSL.
+// Achromatic colors have a hue and saturation of zero.
+func rgbToHSL(r, g, b float64) (h, s, l float64) {
+	hi, lo := max(r, g, b), min(r, g, b)
+	h = hue(r, g, b, hi, lo)
+	l = (hi + lo) / 2
+	if d := hi - lo; d > 0 {
+		s = d / (1 - math.Abs(2*l-1))
+	}
+	return
+}
+
+// Converts a color from RGB to HSV.
+// Achromatic colors have a hue and saturation of zero.
+func rgbToHSV(r, g, b float64) (h, s, v float64) {
+	hi, lo := max(r, g, b), min(r, g, b)
+	h = hue(r, g, b, hi, lo)
+	v = hi
+	if hi > 0 {
+		s = (hi - lo) / hi
+	}
+	return
+}
+
+// Returns the hue, in degrees, of an RGB color with the given maximum and minimum channels.
+func hue(r, g, b, hi, lo float64) float64 {
+	d := hi - lo
+	var h float64
+	switch {
+	case d == 0:
+		return 0
+	case hi == r:
+		h = (g - b) / d
+	case hi == g:
+		h = (b-r)/d + 2
+	default:
+		h = (r-g)/d + 4
+	}
+	return normalizeHue(h * 60)
+}
+
+// Returns the hue, in degrees, wrapped to [0, 360).
+func normalizeHue(h float64) float64 {
+	h = math.Mod(h, 360)
+	if h < 0 {
+		h += 360
+	}
+	if h >= 360 { // math.Mod(-tiny, 360) + 360 rounds to 360.
+		h = 0
+	}
+	return h
+}
@@ -0,0 +1,211 @@
+package pxl_test
+
+import (
+	"fmt"
+	"image/color"
+	"pxl"
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHSL(t *testing.T) {
+	t.Parallel()
+	t.Run("implements the pxl color interface", func(t *testing.T) {
+		var v any = pxl.HSL{}
+		_, ok := v.(pxl.Color)
+		assert.True(t, ok)
+	})
+	t.Run("implements the std color interface", func(t *testing.T) {
+		var v any = pxl.HSL{}
+		_, ok := v.(color.Color)
+		assert.True(t, ok)
+	})
+	t.Run("does not exceed the expected number of bytes", func(t *testing.T) {
+		assert.Equal(t, 32, int(unsafe.Sizeof(pxl.HSL{})))
+	})
+	t.Run("RGBA()", func(t *testing.T) {
+		t.Run("returns the correct values", func(t *testing.T) {
+			testCases := []struct {
+				c pxl.HSL
+				r uint32
+				g uint32
+				b uint32
+				a uint32
+			}{{c: pxl.HSL{H: 0, S: 0, L: 0, A: 0}, r: 0x00000000, g: 0x00000000, b: 0x00000000, a: 0x00000000},
+				{c: pxl.HSL{H: 0, S: 0, L: 1, A: 1}, r: 0x0000ffff, g: 0x0000ffff, b: 0x0000ffff, a: 0x0000ffff},
+				{c: pxl.HSL{H: 120, S: 1, L: 0.5, A: 1}, r: 0x00000000, g: 0x0000ffff, b: 0x00000000, a: 0x0000ffff},
+				{c: pxl.HSL{H: 270, S: 0.5, L: 0.4, A: 1}, r: 0x00006666, g: 0x00003333, b: 0x00009999, a: 0x0000ffff},
+				{c: pxl.HSL{H: -90, S: 0.5, L: 0.4, A: 0.5}, r: 0x00003333, g: 0x0000199a, b: 0x00004ccd, a: 0x00008000}}
+			for _, testCase := range testCases {
+				t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+					r, g, b, a := testCase.c.RGBA()
+					assert.Equal(t, testCase.r, r)
+					assert.Equal(t, testCase.g, g)
+					assert.Equal(t, testCase.b, b)
+					assert.Equal(t, testCase.a, a)
+				})
+			}
+		})
+	})
+	t.Run("Hex()", func(t *testing.T) {
+		t.Run("returns the correct value", func(t *testing.T) {
+			testCases := []struct {
+				c   pxl.HSL
+				hex string
+			}{{c: pxl.HSL{H: 0, S: 0, L: 0, A: 0}, hex: "0000000000000000"},
+				{c: pxl.HSL{H: 0, S: 0, L: 1, A: 1}, hex: "ffffffffffffffff"},
+				{c: pxl.HSL{H: 270, S: 0.5, L: 0.4, A: 1}, hex: "666633339999ffff"}}
+			for _, testCase := range testCases {
+				t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+					assert.Equal(t, testCase.hex, testCase.c.Hex())
+				})
+			}
+		})
+	})
+	t.Run("HSLModel", func(t *testing.T) {
+		t.Run("converts to the correct value", func(t *testing.T) {
+			testCases := []struct {
+				c color.Color
+				e pxl.HSL
+			}{{c: color.Black, e: pxl.HSL{H: 0, S: 0, L: 0, A: 1}},
+				{c: color.NRGBA{R: 0xff, G: 0x00, B: 0x00, A: 0x80}, e: pxl.HSL{H: 0, S: 1, L: 0.5, A: float64(0x8080) / 0xffff}},
+				{c: pxl.RGBA32{R: 0x00, G: 0x00, B: 0xff, A: 0xff}, e: pxl.HSL{H: 240, S: 1, L: 0.5, A: 1}},
+				{c: pxl.RGBA32{R: 0xff, G: 0x00, B: 0xff, A: 0xff}, e: pxl.HSL{H: 300, S: 1, L: 0.5, A: 1}}}
+			for _, testCase := range testCases {
+				t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+					c := pxl.HSLModel.Convert(testCase.c).(pxl.HSL)
+					assert.InDelta(t, testCase.e.H, c.H, 1e-9)
+					assert.InDelta(t, testCase.e.S, c.S, 1e-9)
+					assert.InDelta(t, testCase.e.L, c.L, 1e-9)
+					assert.InDelta(t, testCase.e.A, c.A, 1e-9)
+				})
+			}
+		})
+		t.Run("round-trips RGBA32 colors", func(t *testing.T) {
+			for r := 0; r <= 0xff; r += 5 {
+				for g := 0; g <= 0xff; g += 5 {
+					for b := 0; b <= 0xff; b += 5 {
+						c := pxl.RGBA32{R: uint8(r), G: uint8(g), B: uint8(b), A: uint8(r ^ g ^ b)}
+						if c != pxl.Convert[pxl.RGBA32](pxl.Convert[pxl.HSL](c)) {
+							assert.Fail(t, "round trip failed", "%+v", c)
+						}
+					}
+				}
+			}
+		})
+	})
+}
+
+func BenchmarkHSL(b *testing.B) {
+	b.Run("RGBA()", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			pxl.HSL{H: float64(i % 360), S: 0.5, L: 0.5, A: 1}.RGBA()
+		}
+	})
+	b.Run("Hex()", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			pxl.HSL{H: float64(i % 360), S: 0.5, L: 0.5, A: 1}.Hex()
+		}
+	})
+}
+
+func TestHSV(t *testing.T) {
+	t.Parallel()
+	t.Run("implements the pxl color interface", func(t *testing.T) {
+		var v any = pxl.HSV{}
+		_, ok := v.(pxl.Color)
+		assert.True(t, ok)
+	})
+	t.Run("implements the std color interface", func(t *testing.T) {
+		var v any = pxl.HSV{}
+		_, ok := v.(color.Color)
+		assert.True(t, ok)
+	})
+	t.Run("does not exceed the expected number of bytes", func(t *testing.T) {
+		assert.Equal(t, 32, int(unsafe.Sizeof(pxl.HSV{})))
+	})
+	t.Run("RGBA()", func(t *testing.T) {
+		t.Run("returns the correct values", func(t *testing.T) {
+			testCases := []struct {
+				c pxl.HSV
+				r uint32
+				g uint32
+				b uint32
+				a uint32
+			}{{c: pxl.HSV{H: 0, S: 0, V: 0, A: 0}, r: 0x00000000, g: 0x00000000, b: 0x00000000, a: 0x00000000},
+				{c: pxl.HSV{H: 0, S: 0, V: 1, A: 1}, r: 0x0000ffff, g: 0x0000ffff, b: 0x0000ffff, a: 0x0000ffff},
+				{c: pxl.HSV{H: 120, S: 1, V: 1, A: 1}, r: 0x00000000, g: 0x0000ffff, b: 0x00000000, a: 0x0000ffff},
+				{c: pxl.HSV{H: 270, S: 2.0 / 3.0, V: 0.6, A: 1}, r: 0x00006666, g: 0x00003333, b: 0x00009999, a: 0x0000ffff}}
+			for _, testCase := range testCases {
+				t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+					r, g, b, a := testCase.c.RGBA()
+					assert.Equal(t, testCase.r, r)
+					assert.Equal(t, testCase.g, g)
+					assert.Equal(t, testCase.b, b)
+					assert.Equal(t, testCase.a, a)
+				})
+			}
+		})
+	})
+	t.Run("Hex()", func(t *testing.T) {
+		t.Run("returns the correct value", func(t *testing.T) {
+			testCases := []struct {
+				c   pxl.HSV
+				hex string
+			}{{c: pxl.HSV{H: 0, S: 0, V: 0, A: 0}, hex: "0000000000000000"},
+				{c: pxl.HSV{H: 0, S: 0, V: 1, A: 1}, hex: "ffffffffffffffff"},
+				{c: pxl.HSV{H: 270, S: 2.0 / 3.0, V: 0.6, A: 1}, hex: "666633339999ffff"}}
+			for _, testCase := range testCases {
+				t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+					assert.Equal(t, testCase.hex, testCase.c.Hex())
+				})
+			}
+		})
+	})
+	t.Run("HSVModel", func(t *testing.T) {
+		t.Run("converts to the correct value", func(t *testing.T) {
+			testCases := []struct {
+				c color.Color
+				e pxl.HSV
+			}{{c: color.White, e: pxl.HSV{H: 0, S: 0, V: 1, A: 1}},
+				{c: pxl.RGBA32{R: 0x00, G: 0xff, B: 0xff, A: 0xff}, e: pxl.HSV{H: 180, S: 1, V: 1, A: 1}},
+				{c: pxl.RGBA64{R: 0x8000, G: 0x0000, B: 0x4000, A: 0xffff}, e: pxl.HSV{H: 330, S: 1, V: float64(0x8000) / 0xffff, A: 1}}}
+			for _, testCase := range testCases {
+				t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+					c := pxl.HSVModel.Convert(testCase.c).(pxl.HSV)
+					assert.InDelta(t, testCase.e.H, c.H, 1e-9)
+					assert.InDelta(t, testCase.e.S, c.S, 1e-9)
+					assert.InDelta(t, testCase.e.V, c.V, 1e-9)
+					assert.InDelta(t, testCase.e.A, c.A, 1e-9)
+				})
+			}
+		})
+		t.Run("round-trips RGBA32 colors", func(t *testing.T) {
+			for r := 0; r <= 0xff; r += 5 {
+				for g := 0; g <= 0xff; g += 5 {
+					for b := 0; b <= 0xff; b += 5 {
+						c := pxl.RGBA32{R: uint8(r), G: uint8(g), B: uint8(b), A: uint8(r ^ g ^ b)}
+						if c != pxl.Convert[pxl.RGBA32](pxl.Convert[pxl.HSV](c)) {
+							assert.Fail(t, "round trip failed", "%+v", c)
+						}
+					}
+				}
+			}
+		})
+	})
+}
+
+func BenchmarkHSV(b *testing.B) {
+	b.Run("RGBA()", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			pxl.HSV{H: float64(i % 360), S: 0.5, V: 0.5, A: 1}.RGBA()
+		}
+	})
+	b.Run("Hex()", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			pxl.HSV{H: float64(i % 360), S: 0.5, V: 0.5, A: 1}.Hex()
+		}
+	})
+}
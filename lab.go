@@ -0,0 +1,382 @@
+package pxl
+
+import (
+	"image/color"
+	"math"
+)
+
+// A Lab is a color represented by the perceptual CIE 1976 L*a*b* color model,
+// relative to the D65 white point.
+// L is the lightness, within [0, 100]. A and B are the green-red and blue-yellow
+// axes, roughly within [-128, 127]. Alpha ranges within [0, 1].
+// Lab is not alpha-premultiplied. Colors outside of the sRGB gamut are clipped by RGBA().
+type Lab struct {
+	L, A, B, Alpha float64
+}
+
+// Returns the alpha-premultiplied red, green, blue and alpha values
+// for the color. Each value ranges within [0, 0xffff], but is represented
+// by a uint32 so that multiplying by a blend factor up to 0xffff will not
+// overflow.
+//
+// An alpha-premultiplied color component c has been scaled by alpha (a),
+// so has valid values 0 <= c <= a.
+func (c Lab) RGBA() (r, g, b, a uint32) {
+	return wideOf(c).rgba()
+}
+
+// Returns the hexadecimal code representing the RGBA color,
+// with 16 bits per channel.
+func (c Lab) Hex() string {
+	return convert[RGBA64](c).Hex()
+}
+
+// An LCh is a color represented by the cylindrical form of the CIE 1976 L*a*b* color model,
+// relative to the D65 white point.
+// L is the lightness, within [0, 100]. C is the chroma, roughly within [0, 150].
+// H is the hue in degrees, within [0, 360). Alpha ranges within [0, 1].
+// LCh is not alpha-premultiplied. Colors outside of the sRGB gamut are clipped by RGBA().
+type LCh struct {
+	L, C, H, Alpha float64
+}
+
+// Returns the alpha-premultiplied red, green, blue and alpha values
+// for the color. Each value ranges within [0, 0xffff], but is represented
+// by a uint32 so that multiplying by a blend factor up to 0xffff will not
+// overflow.
+//
+// An alpha-premultiplied color component c has been scaled by alpha (a),
+// so has valid values 0 <= c <= a.
+func (c LCh) RGBA() (r, g, b, a uint32) {
+	return wideOf(c).rgba()
+}
+
+// Returns the hexadecimal code representing the RGBA color,
+// with 16 bits per channel.
+func (c LCh) Hex() string {
+	return convert[RGBA64](c).Hex()
+}
+
+// An OKLab is a color represented by the perceptual Oklab color model.
+// L is the lightness, within [0, 1]. A and B are the green-red and blue-yellow
+// axes, roughly within [-0.4, 0.4]. Alpha ranges within [0, 1].
+// OKLab is not alpha-premultiplied. Colors outside of the sRGB gamut are clipped by RGBA().
+type OKLab struct {
+	L, A, B, Alpha float64
+}
+
+// Returns the alpha-premultiplied red, green, blue and alpha values
+// for the color. Each value ranges within [0, 0xffff], but is represented
+// by a uint32 so that multiplying by a blend factor up to 0xffff will not
+// overflow.
+//
+// An alpha-premultiplied color component c has been scaled by alpha (a),
+// so has valid values 0 <= c <= a.
+func (c OKLab) RGBA() (r, g, b, a uint32) {
+	return wideOf(c).rgba()
+}
+
+// Returns the hexadecimal code representing the RGBA color,
+// with 16 bits per channel.
+func (c OKLab) Hex() string {
+	return convert[RGBA64](c).Hex()
+}
+
+// An OKLCh is a color represented by the cylindrical form of the perceptual Oklab color model.
+// L is the lightness, within [0, 1]. C is the chroma, roughly within [0, 0.4].
+// H is the hue in degrees, within [0, 360). Alpha ranges within [0, 1].
+// OKLCh is not alpha-premultiplied. Colors outside of the sRGB gamut are clipped by RGBA().
+type OKLCh struct {
+	L, C, H, Alpha float64
+}
+
+// Returns the alpha-premultiplied red, green, blue and alpha values
+// for the color. Each value ranges within [0, 0xffff], but is represented
+// by a uint32 so that multiplying by a blend factor up to 0xffff will not
+// overflow.
+//
+// An alpha-premultiplied color component c has been scaled by alpha (a),
+// so has valid values 0 <= c <= a.
+func (c OKLCh) RGBA() (r, g, b, a uint32) {
+	return wideOf(c).rgba()
+}
+
+// Returns the hexadecimal code representing the RGBA color,
+// with 16 bits per channel.
+func (c OKLCh) Hex() string {
+	return convert[RGBA64](c).Hex()
+}
+
+// The D65 white point, in CIE XYZ, with a luminance of 1.
+const (
+	d65X = 0.3127 / 0.3290
+	d65Y = 1.0
+	d65Z = (1 - 0.3127 - 0.3290) / 0.3290
+)
+
+// Constants of the CIE L*a*b* transfer function.
+const (
+	labEpsilon = 216.0 / 24389.0
+	labKappa   = 24389.0 / 27.0
+)
+
+// Converts a color from the sRGB transfer function's encoded values to linear light.
+func srgbToLinear(v float64) float64 {
+	if math.Abs(v) <= 0.04045 {
+		return v / 12.92
+	}
+	return math.Copysign(math.Pow((math.Abs(v)+0.055)/1.055, 2.4), v)
+}
+
+// Converts a color from linear light to the sRGB transfer function's encoded values.
+func linearToSRGB(v float64) float64 {
+	if math.Abs(v) <= 0.0031308 {
+		return v * 12.92
+	}
+	return math.Copysign(1.055*math.Pow(math.Abs(v), 1/2.4)-0.055, v)
+}
+
+// A matrix3 is a 3x3 matrix that transforms colors between linear color spaces.
+type matrix3 [3][3]float64
+
+// Returns the product of the matrix and the column vector (x, y, z).
+func (m *matrix3) mul(x, y, z float64) (float64, float64, float64) {
+	return m[0][0]*x + m[0][1]*y + m[0][2]*z,
+		m[1][0]*x + m[1][1]*y + m[1][2]*z,
+		m[2][0]*x + m[2][1]*y + m[2][2]*z
+}
+
+// Returns the inverse of the matrix.
+func (m *matrix3) inverse() *matrix3 {
+	var inv matrix3
+	inv[0][0] = m[1][1]*m[2][2] - m[1][2]*m[2][1]
+	inv[0][1] = m[0][2]*m[2][1] - m[0][1]*m[2][2]
+	inv[0][2] = m[0][1]*m[1][2] - m[0][2]*m[1][1]
+	inv[1][0] = m[1][2]*m[2][0] - m[1][0]*m[2][2]
+	inv[1][1] = m[0][0]*m[2][2] - m[0][2]*m[2][0]
+	inv[1][2] = m[0][2]*m[1][0] - m[0][0]*m[1][2]
+	inv[2][0] = m[1][0]*m[2][1] - m[1][1]*m[2][0]
+	inv[2][1] = m[0][1]*m[2][0] - m[0][0]*m[2][1]
+	inv[2][2] = m[0][0]*m[1][1] - m[0][1]*m[1][0]
+	det := m[0][0]*inv[0][0] + m[0][1]*inv[1][0] + m[0][2]*inv[2][0]
+	for i := range inv {
+		for j := range inv[i] {
+			inv[i][j] /= det
+		}
+	}
+	return &inv
+}
+
+// Returns the product of the matrix and the matrix n, which transforms colors by n then by m.
+func (m *matrix3) product(n *matrix3) *matrix3 {
+	var p matrix3
+	for i := range p {
+		for j := range p[i] {
+			p[i][j] = m[i][0]*n[0][j] + m[i][1]*n[1][j] + m[i][2]*n[2][j]
+		}
+	}
+	return &p
+}
+
+// Matrices that transform colors between linear-light sRGB and CIE XYZ, relative to the D65 white point.
+var (
+	srgbToXYZ = &matrix3{
+		{506752.0 / 1228815.0, 87881.0 / 245763.0, 12673.0 / 70218.0},
+		{87098.0 / 409605.0, 175762.0 / 245763.0, 12673.0 / 175545.0},
+		{7918.0 / 409605.0, 87881.0 / 737289.0, 1001167.0 / 1053270.0},
+	}
+	xyzToSRGB = srgbToXYZ.inverse()
+)
+
+// Matrices that transform colors between linear-light sRGB and Oklab, as published by Björn Ottosson.
+var (
+	srgbToLMS = &matrix3{
+		{0.4122214708, 0.5363325363, 0.0514459929},
+		{0.2119034982, 0.6806995451, 0.1073969566},
+		{0.0883024619, 0.2817188376, 0.6299787005},
+	}
+	lmsToOKLab = &matrix3{
+		{0.2104542553, 0.7936177850, -0.0040720468},
+		{1.9779984951, -2.4285922050, 0.4505937099},
+		{0.0259040371, 0.7827717662, -0.8086757660},
+	}
+	lmsToSRGB  = srgbToLMS.inverse()
+	okLabToLMS = lmsToOKLab.inverse()
+)
+
+// Converts a color from CIE XYZ, relative to the D65 white point, to CIE L*a*b*.
+func xyzToLab(x, y, z float64) (l, a, b float64) {
+	f := func(t float64) float64 {
+		if t > labEpsilon {
+			return math.Cbrt(t)
+		}
+		return (labKappa*t + 16) / 116
+	}
+	fx, fy, fz := f(x/d65X), f(y/d65Y), f(z/d65Z)
+	return 116*fy - 16, 500 * (fx - fy), 200 * (fy - fz)
+}
+
+// Converts a color from CIE L*a*b* to CIE XYZ, relative to the D65 white point.
+func labToXYZ(l, a, b float64) (x, y, z float64) {
+	fy := (l + 16) / 116
+	fx := a/500 + fy
+	fz := fy - b/200
+	f := func(t float64) float64 {
+		if t3 := t * t * t; t3 > labEpsilon {
+			return t3
+		}
+		return (116*t - 16) / labKappa
+	}
+	y = l / labKappa
+	if l > labKappa*labEpsilon {
+		y = fy * fy * fy
+	}
+	return f(fx) * d65X, y * d65Y, f(fz) * d65Z
+}
+
+// Converts a color from linear-light sRGB to Oklab.
+func linearSRGBToOKLab(r, g, b float64) (l, a, bb float64) {
+	l, m, s := srgbToLMS.mul(r, g, b)
+	return lmsToOKLab.mul(math.Cbrt(l), math.Cbrt(m), math.Cbrt(s))
+}
+
+// Converts a color from Oklab to linear-light sRGB.
+func okLabToLinearSRGB(l, a, b float64) (r, g, bb float64) {
+	l, m, s := okLabToLMS.mul(l, a, b)
+	return lmsToSRGB.mul(l*l*l, m*m*m, s*s*s)
+}
+
+// Converts a color from rectangular to cylindrical coordinates.
+// The hue is in degrees. Achromatic colors have a hue of zero.
+func toPolar(a, b float64) (c, h float64) {
+	c = math.Hypot(a, b)
+	if c < 1e-12 {
+		return c, 0
+	}
+	return c, normalizeHue(math.Atan2(b, a) * 180 / math.Pi)
+}
+
+// Converts a color from cylindrical to rectangular coordinates.
+// The hue is in degrees.
+func fromPolar(c, h float64) (a, b float64) {
+	s, co := math.Sincos(h * math.Pi / 180)
+	return c * co, c * s
+}
+
+// A linear is a non-alpha-premultiplied color whose red, green and blue channels
+// are linear-light sRGB values. Unlike a wide, its channels are not clamped,
+// so it can represent colors outside of the sRGB gamut without loss.
+type linear struct {
+	r, g, b, a float64
+}
+
+// Returns the linear representation of the color, if the color can represent
+// colors outside of the sRGB gamut or of its dynamic range.
+func linearOf(c color.Color) (linear, bool) {
+	switch c := c.(type) {
+	case Lab:
+		return c.linear(), true
+	case LCh:
+		return c.lab().linear(), true
+	case OKLab:
+		return c.linear(), true
+	case OKLCh:
+		return c.okLab().linear(), true
+	case RGBAF32:
+		return linear{r: float64(c.R), g: float64(c.G), b: float64(c.B), a: float64(c.A)}, true
+	case RGBAF64:
+		return linear{r: c.R, g: c.G, b: c.B, a: c.A}, true
+	case GrayF32:
+		return linear{r: float64(c), g: float64(c), b: float64(c), a: 1}, true
+	case GrayF64:
+		return linear{r: float64(c), g: float64(c), b: float64(c), a: 1}, true
+	}
+	return linear{}, false
+}
+
+// Returns the color of type T closest to the linear color,
+// if T can represent colors outside of the sRGB gamut or of its dynamic range.
+func fromLinear[T Color](l linear) (T, bool) {
+	var c T
+	switch p := any(&c).(type) {
+	case *Lab:
+		*p = l.lab()
+	case *LCh:
+		*p = l.lab().lch()
+	case *OKLab:
+		*p = l.okLab()
+	case *OKLCh:
+		*p = l.okLab().okLCh()
+	case *RGBAF32:
+		*p = RGBAF32{R: float32(l.r), G: float32(l.g), B: float32(l.b), A: float32(l.a)}
+	case *RGBAF64:
+		*p = RGBAF64{R: l.r, G: l.g, B: l.b, A: l.a}
+	case *GrayF32:
+		*p = GrayF32(l.luminance())
+	case *GrayF64:
+		*p = GrayF64(l.luminance())
+	default:
+		return c, false
+	}
+	return c, true
+}
+
+// Returns the linear representation of the wide color.
+func (w wide) linear() linear {
+	r, g, b, a := w.floats()
+	return linear{r: srgbToLinear(r), g: srgbToLinear(g), b: srgbToLinear(b), a: a}
+}
+
+// Returns the wide representation of the linear color.
+// Colors outside of the sRGB gamut are clipped.
+func (l linear) wide() wide {
+	return wideFromFloats(linearToSRGB(l.r), linearToSRGB(l.g), linearToSRGB(l.b), l.a)
+}
+
+// Returns the CIE L*a*b* representation of the linear color.
+func (l linear) lab() Lab {
+	ll, a, b := xyzToLab(srgbToXYZ.mul(l.r, l.g, l.b))
+	return Lab{L: ll, A: a, B: b, Alpha: l.a}
+}
+
+// Returns the Oklab representation of the linear color.
+func (l linear) okLab() OKLab {
+	ll, a, b := linearSRGBToOKLab(l.r, l.g, l.b)
+	return OKLab{L: ll, A: a, B: b, Alpha: l.a}
+}
+
+// Returns the linear representation of the CIE L*a*b* color.
+func (c Lab) linear() linear {
+	r, g, b := xyzToSRGB.mul(labToXYZ(c.L, c.A, c.B))
+	return linear{r: r, g: g, b: b, a: c.Alpha}
+}
+
+// Returns the linear representation of the Oklab color.
+func (c OKLab) linear() linear {
+	r, g, b := okLabToLinearSRGB(c.L, c.A, c.B)
+	return linear{r: r, g: g, b: b, a: c.Alpha}
+}
+
+// Returns the CIE L*a*b* representation of the CIE LCh color.
+func (c LCh) lab() Lab {
+	a, b := fromPolar(c.C, c.H)
+	return Lab{L: c.L, A: a, B: b, Alpha: c.Alpha}
+}
+
+// Returns the CIE LCh representation of the CIE L*a*b* color.
+func (c Lab) lch() LCh {
+	ch, h := toPolar(c.A, c.B)
+	return LCh{L: c.L, C: ch, H: h, Alpha: c.Alpha}
+}
+
+// Returns the Oklab representation of the OKLCh color.
+func (c OKLCh) okLab() OKLab {
+	a, b := fromPolar(c.C, c.H)
+	return OKLab{L: c.L, A: a, B: b, Alpha: c.Alpha}
+}
+
+// Returns the OKLCh representation of the Oklab color.
+func (c OKLab) okLCh() OKLCh {
+	ch, h := toPolar(c.A, c.B)
+	return OKLCh{L: c.L, C: ch, H: h, Alpha: c.Alpha}
+}
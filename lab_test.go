@@ -0,0 +1,259 @@
+package pxl_test
+
+import (
+	"fmt"
+	"image/color"
+	"pxl"
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLab(t *testing.T) {
+	t.Parallel()
+	t.Run("implements the pxl color interface", func(t *testing.T) {
+		var v any = pxl.Lab{}
+		_, ok := v.(pxl.Color)
+		assert.True(t, ok)
+	})
+	t.Run("implements the std color interface", func(t *testing.T) {
+		var v any = pxl.Lab{}
+		_, ok := v.(color.Color)
+		assert.True(t, ok)
+	})
+	t.Run("does not exceed the expected number of bytes", func(t *testing.T) {
+		assert.Equal(t, 32, int(unsafe.Sizeof(pxl.Lab{})))
+	})
+	t.Run("Hex()", func(t *testing.T) {
+		t.Run("returns the correct value", func(t *testing.T) {
+			testCases := []struct {
+				c   pxl.Lab
+				hex string
+			}{{c: pxl.Lab{L: 0, A: 0, B: 0, Alpha: 1}, hex: "000000000000ffff"},
+				{c: pxl.Lab{L: 100, A: 0, B: 0, Alpha: 1}, hex: "ffffffffffffffff"},
+				{c: pxl.Lab{L: 100, A: 0, B: 0, Alpha: 0}, hex: "ffffffffffff0000"}}
+			for _, testCase := range testCases {
+				t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+					assert.Equal(t, testCase.hex, testCase.c.Hex())
+				})
+			}
+		})
+	})
+	t.Run("LabModel", func(t *testing.T) {
+		t.Run("converts to the correct value", func(t *testing.T) {
+			testCases := []struct {
+				c color.Color
+				e pxl.Lab
+			}{{c: color.White, e: pxl.Lab{L: 100, A: 0, B: 0, Alpha: 1}},
+				{c: color.Black, e: pxl.Lab{L: 0, A: 0, B: 0, Alpha: 1}},
+				{c: pxl.RGBA32{R: 0xff, G: 0x00, B: 0x00, A: 0xff}, e: pxl.Lab{L: 53.2371, A: 80.0901, B: 67.2033, Alpha: 1}},
+				{c: pxl.RGBA32{R: 0x00, G: 0xff, B: 0x00, A: 0xff}, e: pxl.Lab{L: 87.7355, A: -86.1816, B: 83.1866, Alpha: 1}},
+				{c: pxl.RGBA32{R: 0x00, G: 0x00, B: 0xff, A: 0xff}, e: pxl.Lab{L: 32.3009, A: 79.1953, B: -107.8555, Alpha: 1}}}
+			for _, testCase := range testCases {
+				t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+					c := pxl.LabModel.Convert(testCase.c).(pxl.Lab)
+					assert.InDelta(t, testCase.e.L, c.L, 1e-3)
+					assert.InDelta(t, testCase.e.A, c.A, 1e-3)
+					assert.InDelta(t, testCase.e.B, c.B, 1e-3)
+					assert.InDelta(t, testCase.e.Alpha, c.Alpha, 1e-9)
+				})
+			}
+		})
+		t.Run("round-trips RGBA32 colors", func(t *testing.T) {
+			for r := 0; r <= 0xff; r += 5 {
+				for g := 0; g <= 0xff; g += 5 {
+					for b := 0; b <= 0xff; b += 5 {
+						c := pxl.RGBA32{R: uint8(r), G: uint8(g), B: uint8(b), A: uint8(r ^ g ^ b)}
+						if c != pxl.Convert[pxl.RGBA32](pxl.Convert[pxl.Lab](c)) {
+							assert.Fail(t, "round trip failed", "%+v", c)
+						}
+					}
+				}
+			}
+		})
+		t.Run("round-trips RGBA64 colors", func(t *testing.T) {
+			for i := 0; i <= 0xffff; i += 0x0101 / 3 {
+				c := pxl.RGBA64{R: uint16(i), G: uint16(0xffff - i), B: uint16(i * 7), A: uint16(i * 13)}
+				assert.Equal(t, c, pxl.Convert[pxl.RGBA64](pxl.Convert[pxl.Lab](c)))
+			}
+		})
+	})
+}
+
+func BenchmarkLab(b *testing.B) {
+	b.Run("RGBA()", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			pxl.Lab{L: float64(i % 100), A: 20, B: -20, Alpha: 1}.RGBA()
+		}
+	})
+	b.Run("Hex()", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			pxl.Lab{L: float64(i % 100), A: 20, B: -20, Alpha: 1}.Hex()
+		}
+	})
+}
+
+func TestLCh(t *testing.T) {
+	t.Parallel()
+	t.Run("implements the pxl color interface", func(t *testing.T) {
+		var v any = pxl.LCh{}
+		_, ok := v.(pxl.Color)
+		assert.True(t, ok)
+	})
+	t.Run("does not exceed the expected number of bytes", func(t *testing.T) {
+		assert.Equal(t, 32, int(unsafe.Sizeof(pxl.LCh{})))
+	})
+	t.Run("LChModel", func(t *testing.T) {
+		t.Run("converts to the correct value", func(t *testing.T) {
+			testCases := []struct {
+				c color.Color
+				e pxl.LCh
+			}{{c: color.White, e: pxl.LCh{L: 100, C: 0, H: 0, Alpha: 1}},
+				{c: pxl.RGBA32{R: 0xff, G: 0x00, B: 0x00, A: 0xff}, e: pxl.LCh{L: 53.2371, C: 104.5500, H: 39.9999, Alpha: 1}},
+				{c: pxl.Lab{L: 50, A: 0, B: -20, Alpha: 0.5}, e: pxl.LCh{L: 50, C: 20, H: 270, Alpha: 0.5}}}
+			for _, testCase := range testCases {
+				t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+					c := pxl.LChModel.Convert(testCase.c).(pxl.LCh)
+					assert.InDelta(t, testCase.e.L, c.L, 1e-3)
+					assert.InDelta(t, testCase.e.C, c.C, 1e-3)
+					assert.InDelta(t, testCase.e.H, c.H, 1e-3)
+					assert.InDelta(t, testCase.e.Alpha, c.Alpha, 1e-9)
+				})
+			}
+		})
+		t.Run("does not clip colors outside of the sRGB gamut", func(t *testing.T) {
+			c := pxl.LCh{L: 50, C: 150, H: 200, Alpha: 1}
+			lab := pxl.Convert[pxl.Lab](c)
+			assert.InDelta(t, c.C, pxl.Convert[pxl.LCh](lab).C, 1e-9)
+			assert.InDelta(t, c.C, pxl.Convert[pxl.LCh](pxl.Convert[pxl.OKLab](lab)).C, 1e-9)
+		})
+		t.Run("round-trips RGBA32 colors", func(t *testing.T) {
+			for r := 0; r <= 0xff; r += 5 {
+				for g := 0; g <= 0xff; g += 5 {
+					for b := 0; b <= 0xff; b += 5 {
+						c := pxl.RGBA32{R: uint8(r), G: uint8(g), B: uint8(b), A: 0xff}
+						if c != pxl.Convert[pxl.RGBA32](pxl.Convert[pxl.LCh](c)) {
+							assert.Fail(t, "round trip failed", "%+v", c)
+						}
+					}
+				}
+			}
+		})
+	})
+}
+
+func TestOKLab(t *testing.T) {
+	t.Parallel()
+	t.Run("implements the pxl color interface", func(t *testing.T) {
+		var v any = pxl.OKLab{}
+		_, ok := v.(pxl.Color)
+		assert.True(t, ok)
+	})
+	t.Run("does not exceed the expected number of bytes", func(t *testing.T) {
+		assert.Equal(t, 32, int(unsafe.Sizeof(pxl.OKLab{})))
+	})
+	t.Run("Hex()", func(t *testing.T) {
+		t.Run("returns the correct value", func(t *testing.T) {
+			testCases := []struct {
+				c   pxl.OKLab
+				hex string
+			}{{c: pxl.OKLab{L: 0, A: 0, B: 0, Alpha: 1}, hex: "000000000000ffff"},
+				{c: pxl.OKLab{L: 1, A: 0, B: 0, Alpha: 1}, hex: "ffffffffffffffff"},
+				{c: pxl.OKLab{L: 0.627955, A: 0.224863, B: 0.125846, Alpha: 1}, hex: "ffff00000000ffff"}}
+			for _, testCase := range testCases {
+				t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+					assert.Equal(t, testCase.hex, testCase.c.Hex())
+				})
+			}
+		})
+	})
+	t.Run("OKLabModel", func(t *testing.T) {
+		t.Run("converts to the correct value", func(t *testing.T) {
+			testCases := []struct {
+				c color.Color
+				e pxl.OKLab
+			}{{c: color.White, e: pxl.OKLab{L: 1, A: 0, B: 0, Alpha: 1}},
+				{c: pxl.RGBA32{R: 0xff, G: 0x00, B: 0x00, A: 0xff}, e: pxl.OKLab{L: 0.627955, A: 0.224863, B: 0.125846, Alpha: 1}},
+				{c: pxl.RGBA32{R: 0x00, G: 0xff, B: 0x00, A: 0xff}, e: pxl.OKLab{L: 0.866440, A: -0.233888, B: 0.179498, Alpha: 1}},
+				{c: pxl.RGBA32{R: 0x00, G: 0x00, B: 0xff, A: 0xff}, e: pxl.OKLab{L: 0.452014, A: -0.032457, B: -0.311528, Alpha: 1}}}
+			for _, testCase := range testCases {
+				t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+					c := pxl.OKLabModel.Convert(testCase.c).(pxl.OKLab)
+					assert.InDelta(t, testCase.e.L, c.L, 1e-5)
+					assert.InDelta(t, testCase.e.A, c.A, 1e-5)
+					assert.InDelta(t, testCase.e.B, c.B, 1e-5)
+					assert.InDelta(t, testCase.e.Alpha, c.Alpha, 1e-9)
+				})
+			}
+		})
+		t.Run("round-trips RGBA32 colors", func(t *testing.T) {
+			for r := 0; r <= 0xff; r += 5 {
+				for g := 0; g <= 0xff; g += 5 {
+					for b := 0; b <= 0xff; b += 5 {
+						c := pxl.RGBA32{R: uint8(r), G: uint8(g), B: uint8(b), A: uint8(r ^ g ^ b)}
+						if c != pxl.Convert[pxl.RGBA32](pxl.Convert[pxl.OKLab](c)) {
+							assert.Fail(t, "round trip failed", "%+v", c)
+						}
+					}
+				}
+			}
+		})
+	})
+}
+
+func BenchmarkOKLab(b *testing.B) {
+	b.Run("RGBA()", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			pxl.OKLab{L: float64(i%100) / 100, A: 0.1, B: -0.1, Alpha: 1}.RGBA()
+		}
+	})
+	b.Run("Hex()", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			pxl.OKLab{L: float64(i%100) / 100, A: 0.1, B: -0.1, Alpha: 1}.Hex()
+		}
+	})
+}
+
+func TestOKLCh(t *testing.T) {
+	t.Parallel()
+	t.Run("implements the pxl color interface", func(t *testing.T) {
+		var v any = pxl.OKLCh{}
+		_, ok := v.(pxl.Color)
+		assert.True(t, ok)
+	})
+	t.Run("does not exceed the expected number of bytes", func(t *testing.T) {
+		assert.Equal(t, 32, int(unsafe.Sizeof(pxl.OKLCh{})))
+	})
+	t.Run("OKLChModel", func(t *testing.T) {
+		t.Run("converts to the correct value", func(t *testing.T) {
+			testCases := []struct {
+				c color.Color
+				e pxl.OKLCh
+			}{{c: color.Black, e: pxl.OKLCh{L: 0, C: 0, H: 0, Alpha: 1}},
+				{c: pxl.RGBA32{R: 0xff, G: 0x00, B: 0x00, A: 0xff}, e: pxl.OKLCh{L: 0.627955, C: 0.257683, H: 29.2339, Alpha: 1}},
+				{c: pxl.RGBA32{R: 0x66, G: 0x33, B: 0x99, A: 0xff}, e: pxl.OKLCh{L: 0.440279, C: 0.160296, H: 303.3726, Alpha: 1}}}
+			for _, testCase := range testCases {
+				t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+					c := pxl.OKLChModel.Convert(testCase.c).(pxl.OKLCh)
+					assert.InDelta(t, testCase.e.L, c.L, 1e-4)
+					assert.InDelta(t, testCase.e.C, c.C, 1e-4)
+					assert.InDelta(t, testCase.e.H, c.H, 1e-3)
+					assert.InDelta(t, testCase.e.Alpha, c.Alpha, 1e-9)
+				})
+			}
+		})
+		t.Run("round-trips RGBA32 colors", func(t *testing.T) {
+			for r := 0; r <= 0xff; r += 5 {
+				for g := 0; g <= 0xff; g += 5 {
+					for b := 0; b <= 0xff; b += 5 {
+						c := pxl.RGBA32{R: uint8(r), G: uint8(g), B: uint8(b), A: 0xff}
+						if c != pxl.Convert[pxl.RGBA32](pxl.Convert[pxl.OKLCh](c)) {
+							assert.Fail(t, "round trip failed", "%+v", c)
+						}
+					}
+				}
+			}
+		})
+	})
+}
@@ -0,0 +1,47 @@
+package pxl
+
+import "image"
+
+// A Mask is an in-memory image of 8-bit alpha values, used to clip and select pixels.
+// Like the standard library's [image.Alpha], it can be passed as the mask of [image/draw.DrawMask],
+// and it can be used as the mask of [CompositeImageMask], [BlendMask] and [Layer].
+type Mask = Grid[Alpha8]
+
+// Returns a new Mask with the given bounds.
+// Every pixel is initialized to transparent, which masks out everything.
+func NewMask(r image.Rectangle) *Mask {
+	return NewImage[Alpha8](r)
+}
+
+// Returns the alpha of the mask at (x, y), or opaque if there is no mask.
+func maskAt(mask image.Image, x, y int) uint64 {
+	if mask == nil {
+		return wideMax
+	}
+	if m, ok := mask.(*Mask); ok {
+		return uint64(m.Get(x, y)) * 0x0101010101010101
+	}
+	_, _, _, a := mask.At(x, y).RGBA()
+	return uint64(a) * 0x0001000100010001
+}
+
+// Returns the interpolation from d to c by the fraction m, which ranges within [0, 0xffffffffffffffff].
+// Like the colors, the interpolation is alpha-premultiplied.
+func lerp(d, c wide, m uint64) wide {
+	switch m {
+	case 0:
+		return d
+	case wideMax:
+		return c
+	}
+	wc := mulDiv(m, c.a, wideMax)
+	wd := mulDiv(wideMax-m, d.a, wideMax)
+	if wc > wideMax-wd {
+		wd = wideMax - wc
+	}
+	a := wc + wd
+	if a == 0 {
+		return wide{}
+	}
+	return wide{r: mean(c.r, wc, d.r, wd), g: mean(c.g, wc, d.g, wd), b: mean(c.b, wc, d.b, wd), a: a}
+}
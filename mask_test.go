@@ -0,0 +1,75 @@
+package pxl_test
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"pxl"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMask(t *testing.T) {
+	t.Parallel()
+	t.Run("is an image of Alpha8 colors", func(t *testing.T) {
+		m := pxl.NewMask(image.Rect(0, 0, 2, 2))
+		var img pxl.Image[pxl.Alpha8] = m
+		assert.Equal(t, image.Rect(0, 0, 2, 2), img.Bounds())
+		assert.Equal(t, pxl.Alpha8Model, img.ColorModel())
+		assert.Equal(t, pxl.Alpha8(0x00), img.Get(0, 0))
+	})
+	t.Run("can be used as an image/draw mask", func(t *testing.T) {
+		m := pxl.NewMask(image.Rect(0, 0, 2, 1))
+		m.Set(0, 0, 0xff)
+		m.Set(1, 0, 0x80)
+		dst := image.NewNRGBA(image.Rect(0, 0, 2, 1))
+		draw.DrawMask(dst, dst.Bounds(), image.NewUniform(color.White), image.Point{}, m, image.Point{}, draw.Src)
+		assert.Equal(t, color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}, dst.NRGBAAt(0, 0))
+		assert.Equal(t, color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0x80}, dst.NRGBAAt(1, 0))
+	})
+}
+
+func TestCompositeImageMask(t *testing.T) {
+	t.Parallel()
+	t.Run("interpolates the result by the mask", func(t *testing.T) {
+		red := pxl.RGBA32{R: 0xff, G: 0x00, B: 0x00, A: 0xff}
+		blue := pxl.RGBA32{R: 0x00, G: 0x00, B: 0xff, A: 0xff}
+		dst := filled(image.Rect(0, 0, 3, 1), blue)
+		src := filled(image.Rect(0, 0, 3, 1), red)
+		m := pxl.NewMask(image.Rect(0, 0, 3, 1))
+		m.Set(0, 0, 0xff)
+		m.Set(1, 0, 0x80)
+		pxl.CompositeImageMask[pxl.RGBA32](dst, src, image.Point{}, m, pxl.Src)
+		assert.Equal(t, []pxl.RGBA32{red, {R: 0x80, G: 0x00, B: 0x7f, A: 0xff}, blue}, dst.Pix)
+	})
+	t.Run("aligns the mask with the source", func(t *testing.T) {
+		dst := pxl.NewImage[pxl.RGBA32](image.Rect(0, 0, 3, 1))
+		src := filled(image.Rect(0, 0, 2, 1), pxl.RGBA32{R: 0xff, A: 0xff})
+		m := pxl.NewMask(image.Rect(0, 0, 2, 1))
+		m.Set(1, 0, 0xff)
+		pxl.CompositeImageMask[pxl.RGBA32](dst, src, image.Pt(1, 0), m, pxl.SrcOver)
+		assert.Equal(t, []pxl.RGBA32{{}, {}, {R: 0xff, A: 0xff}}, dst.Pix)
+	})
+	t.Run("accepts masks of any type", func(t *testing.T) {
+		dst := pxl.NewImage[pxl.RGBA32](image.Rect(0, 0, 2, 1))
+		src := filled(image.Rect(0, 0, 2, 1), pxl.RGBA32{R: 0xff, A: 0xff})
+		m := pxl.NewImage[pxl.Alpha1](image.Rect(0, 0, 2, 1))
+		m.Set(0, 0, true)
+		pxl.CompositeImageMask[pxl.RGBA32](dst, src, image.Point{}, m, pxl.SrcOver)
+		assert.Equal(t, []pxl.RGBA32{{R: 0xff, A: 0xff}, {}}, dst.Pix)
+	})
+}
+
+func TestBlendMask(t *testing.T) {
+	t.Parallel()
+	t.Run("scales the opacity by the mask", func(t *testing.T) {
+		b := pxl.RGBA32{R: 0xcc, G: 0x33, B: 0x66, A: 0xff}
+		dst := filled(image.Rect(0, 0, 2, 1), b)
+		src := filled(image.Rect(0, 0, 2, 1), pxl.RGBA32{R: 0x33, G: 0x99, B: 0xff, A: 0xff})
+		m := pxl.NewMask(image.Rect(0, 0, 2, 1))
+		m.Set(0, 0, 0xff)
+		pxl.BlendMask[pxl.RGBA32](dst, src, m, pxl.Multiply, 0.5)
+		assert.Equal(t, []pxl.RGBA32{{R: 0x7a, G: 0x29, B: 0x66, A: 0xff}, b}, dst.Pix)
+	})
+}
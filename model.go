@@ -0,0 +1,163 @@
+package pxl
+
+import "image/color"
+
+// Models for the pxl color types.
+// Each model converts colors as described by [Convert].
+var (
+	RGBA8Model    color.Model = color.ModelFunc(model[RGBA8])
+	RGBA16Model   color.Model = color.ModelFunc(model[RGBA16])
+	RGB565Model   color.Model = color.ModelFunc(model[RGB565])
+	RGB555Model   color.Model = color.ModelFunc(model[RGB555])
+	ARGB1555Model color.Model = color.ModelFunc(model[ARGB1555])
+	RGB444Model   color.Model = color.ModelFunc(model[RGB444])
+	RGBA32Model   color.Model = color.ModelFunc(model[RGBA32])
+	RGBA64Model   color.Model = color.ModelFunc(model[RGBA64])
+	RGBA128Model  color.Model = color.ModelFunc(model[RGBA128])
+	RGBA256Model  color.Model = color.ModelFunc(model[RGBA256])
+	BGRA32Model   color.Model = color.ModelFunc(model[BGRA32])
+	ARGB32Model   color.Model = color.ModelFunc(model[ARGB32])
+	ABGR32Model   color.Model = color.ModelFunc(model[ABGR32])
+	BGRA64Model   color.Model = color.ModelFunc(model[BGRA64])
+	ARGB64Model   color.Model = color.ModelFunc(model[ARGB64])
+	ABGR64Model   color.Model = color.ModelFunc(model[ABGR64])
+	PRGBA32Model  color.Model = color.ModelFunc(model[PRGBA32])
+	PRGBA64Model  color.Model = color.ModelFunc(model[PRGBA64])
+	PRGBA128Model color.Model = color.ModelFunc(model[PRGBA128])
+	PRGBA256Model color.Model = color.ModelFunc(model[PRGBA256])
+	RGBAF32Model  color.Model = color.ModelFunc(model[RGBAF32])
+	RGBAF64Model  color.Model = color.ModelFunc(model[RGBAF64])
+	GrayF32Model  color.Model = color.ModelFunc(model[GrayF32])
+	GrayF64Model  color.Model = color.ModelFunc(model[GrayF64])
+	CMYK32Model   color.Model = color.ModelFunc(model[CMYK32])
+	CMYK64Model   color.Model = color.ModelFunc(model[CMYK64])
+	Gray8Model    color.Model = color.ModelFunc(model[Gray8])
+	Gray16Model   color.Model = color.ModelFunc(model[Gray16])
+	Gray32Model   color.Model = color.ModelFunc(model[Gray32])
+	Gray64Model   color.Model = color.ModelFunc(model[Gray64])
+	GrayA16Model  color.Model = color.ModelFunc(model[GrayA16])
+	GrayA32Model  color.Model = color.ModelFunc(model[GrayA32])
+	GrayA64Model  color.Model = color.ModelFunc(model[GrayA64])
+	GrayA128Model color.Model = color.ModelFunc(model[GrayA128])
+	MonoModel     color.Model = color.ModelFunc(model[Mono])
+	Alpha1Model   color.Model = color.ModelFunc(model[Alpha1])
+	Alpha8Model   color.Model = color.ModelFunc(model[Alpha8])
+	Alpha16Model  color.Model = color.ModelFunc(model[Alpha16])
+	HSLModel      color.Model = color.ModelFunc(model[HSL])
+	HSVModel      color.Model = color.ModelFunc(model[HSV])
+	YCbCrModel    color.Model = color.ModelFunc(model[YCbCr])
+	LabModel      color.Model = color.ModelFunc(model[Lab])
+	LChModel      color.Model = color.ModelFunc(model[LCh])
+	OKLabModel    color.Model = color.ModelFunc(model[OKLab])
+	OKLChModel    color.Model = color.ModelFunc(model[OKLCh])
+)
+
+// Converts any color into T. See [Convert].
+func model[T Color](c color.Color) color.Color {
+	return convert[T](c)
+}
+
+// Returns the model that converts colors into T.
+// Colors outside of this package fall back to a model that leaves colors of
+// type T untouched and converts every other color using the standard library's [color.NRGBA64Model].
+func modelFor[T Color]() color.Model {
+	var zero T
+	switch any(zero).(type) {
+	case RGBA8:
+		return RGBA8Model
+	case RGBA16:
+		return RGBA16Model
+	case RGB565:
+		return RGB565Model
+	case RGB555:
+		return RGB555Model
+	case ARGB1555:
+		return ARGB1555Model
+	case RGB444:
+		return RGB444Model
+	case RGBA32:
+		return RGBA32Model
+	case RGBA64:
+		return RGBA64Model
+	case RGBA128:
+		return RGBA128Model
+	case RGBA256:
+		return RGBA256Model
+	case BGRA32:
+		return BGRA32Model
+	case ARGB32:
+		return ARGB32Model
+	case ABGR32:
+		return ABGR32Model
+	case BGRA64:
+		return BGRA64Model
+	case ARGB64:
+		return ARGB64Model
+	case ABGR64:
+		return ABGR64Model
+	case PRGBA32:
+		return PRGBA32Model
+	case PRGBA64:
+		return PRGBA64Model
+	case PRGBA128:
+		return PRGBA128Model
+	case PRGBA256:
+		return PRGBA256Model
+	case RGBAF32:
+		return RGBAF32Model
+	case RGBAF64:
+		return RGBAF64Model
+	case GrayF32:
+		return GrayF32Model
+	case GrayF64:
+		return GrayF64Model
+	case CMYK32:
+		return CMYK32Model
+	case CMYK64:
+		return CMYK64Model
+	case Gray8:
+		return Gray8Model
+	case Gray16:
+		return Gray16Model
+	case Gray32:
+		return Gray32Model
+	case Gray64:
+		return Gray64Model
+	case GrayA16:
+		return GrayA16Model
+	case GrayA32:
+		return GrayA32Model
+	case GrayA64:
+		return GrayA64Model
+	case GrayA128:
+		return GrayA128Model
+	case Mono:
+		return MonoModel
+	case Alpha1:
+		return Alpha1Model
+	case Alpha8:
+		return Alpha8Model
+	case Alpha16:
+		return Alpha16Model
+	case HSL:
+		return HSLModel
+	case HSV:
+		return HSVModel
+	case YCbCr:
+		return YCbCrModel
+	case Lab:
+		return LabModel
+	case LCh:
+		return LChModel
+	case OKLab:
+		return OKLabModel
+	case OKLCh:
+		return OKLChModel
+	}
+	return color.ModelFunc(func(c color.Color) color.Color {
+		if _, ok := c.(T); ok {
+			return c
+		}
+		return color.NRGBA64Model.Convert(c)
+	})
+}
@@ -0,0 +1,99 @@
+package pxl_test
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"pxl"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestModels(t *testing.T) {
+	t.Parallel()
+	t.Run("convert std colors to the correct values", func(t *testing.T) {
+		testCases := []struct {
+			m color.Model
+			c color.Color
+			e color.Color
+		}{{m: pxl.RGBA8Model, c: color.NRGBA{R: 0xaa, G: 0x55, B: 0xaa, A: 0xff}, e: pxl.RGBA8(0x9b)},
+			{m: pxl.RGBA8Model, c: color.Transparent, e: pxl.RGBA8(0x00)},
+			{m: pxl.RGBA16Model, c: color.NRGBA{R: 0xaa, G: 0x55, B: 0xaa, A: 0xff}, e: pxl.RGBA16(0xa5af)},
+			{m: pxl.RGBA16Model, c: color.NRGBA{R: 0xaa, G: 0x55, B: 0xaa, A: 0x80}, e: pxl.RGBA16(0xa5a8)},
+			{m: pxl.RGBA32Model, c: color.RGBA{R: 0x40, G: 0x20, B: 0x00, A: 0x80}, e: pxl.RGBA32{R: 0x80, G: 0x40, B: 0x00, A: 0x80}},
+			{m: pxl.RGBA32Model, c: color.NRGBA64{R: 0x9c00, G: 0x0000, B: 0xffff, A: 0xffff}, e: pxl.RGBA32{R: 0x9b, G: 0x00, B: 0xff, A: 0xff}},
+			{m: pxl.RGBA64Model, c: color.NRGBA64{R: 0x1234, G: 0x5678, B: 0x9abc, A: 0xffff}, e: pxl.RGBA64{R: 0x1234, G: 0x5678, B: 0x9abc, A: 0xffff}},
+			{m: pxl.RGBA128Model, c: color.NRGBA{R: 0xaa, G: 0x55, B: 0x00, A: 0xff}, e: pxl.RGBA128{R: 0xaaaaaaaa, G: 0x55555555, B: 0x00000000, A: 0xffffffff}},
+			{m: pxl.RGBA256Model, c: color.White, e: pxl.RGBA256{R: 0xffffffffffffffff, G: 0xffffffffffffffff, B: 0xffffffffffffffff, A: 0xffffffffffffffff}},
+			{m: pxl.Gray8Model, c: color.Gray16{Y: 0x9c00}, e: pxl.Gray8(0x9b)},
+			{m: pxl.Gray8Model, c: color.White, e: pxl.Gray8(0xff)},
+			{m: pxl.Gray16Model, c: color.Gray16{Y: 0xa5af}, e: pxl.Gray16(0xa5af)},
+			{m: pxl.Gray32Model, c: color.Gray{Y: 0xaa}, e: pxl.Gray32(0xaaaaaaaa)},
+			{m: pxl.Gray64Model, c: color.Black, e: pxl.Gray64(0x0000000000000000)}}
+		for _, testCase := range testCases {
+			t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+				assert.Equal(t, testCase.e, testCase.m.Convert(testCase.c))
+			})
+		}
+	})
+	t.Run("leave colors of their own type untouched", func(t *testing.T) {
+		testCases := []struct {
+			m color.Model
+			c color.Color
+		}{{m: pxl.RGBA8Model, c: pxl.RGBA8(0x9c)},
+			{m: pxl.RGBA16Model, c: pxl.RGBA16(0xa5a0)},
+			{m: pxl.RGBA32Model, c: pxl.RGBA32{R: 0xff, G: 0xff, B: 0xff, A: 0x00}},
+			{m: pxl.RGBA64Model, c: pxl.RGBA64{R: 0xffff, G: 0xffff, B: 0xffff, A: 0x0000}},
+			{m: pxl.RGBA128Model, c: pxl.RGBA128{R: 0x12345678, G: 0x9abcdef0, B: 0x0fedcba9, A: 0x87654321}},
+			{m: pxl.RGBA256Model, c: pxl.RGBA256{R: 0x0123456789abcdef, G: 0xfedcba9876543210, B: 0x00ff00ff00ff00ff, A: 0xff00ff00ff00ff00}},
+			{m: pxl.Gray8Model, c: pxl.Gray8(0x9b)},
+			{m: pxl.Gray16Model, c: pxl.Gray16(0xa5af)},
+			{m: pxl.Gray32Model, c: pxl.Gray32(0xaa55aaff)},
+			{m: pxl.Gray64Model, c: pxl.Gray64(0xaaaa5555aaaaffff)}}
+		for _, testCase := range testCases {
+			t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+				assert.Equal(t, testCase.c, testCase.m.Convert(testCase.c))
+			})
+		}
+	})
+	t.Run("round-trip every RGBA8 color through RGBA64", func(t *testing.T) {
+		for i := 0; i <= 0xff; i++ {
+			c := pxl.RGBA8(i)
+			assert.Equal(t, c, pxl.RGBA8Model.Convert(pxl.RGBA64Model.Convert(c)))
+		}
+	})
+	t.Run("round-trip every RGBA16 color through RGBA64", func(t *testing.T) {
+		for i := 0; i <= 0xffff; i++ {
+			c := pxl.RGBA16(i)
+			if c != pxl.RGBA16Model.Convert(pxl.RGBA64Model.Convert(c)) {
+				assert.Fail(t, "round trip failed", "%04x", i)
+			}
+		}
+	})
+	t.Run("are used by images of their color type", func(t *testing.T) {
+		r := image.Rect(0, 0, 1, 1)
+		assert.Equal(t, pxl.Gray16Model, pxl.NewImage[pxl.Gray16](r).ColorModel())
+		assert.Equal(t, pxl.RGBA8Model, pxl.NewRGBA8Image(r).ColorModel())
+		assert.Equal(t, pxl.RGBA16Model, pxl.NewRGBA16Image(r).ColorModel())
+	})
+}
+
+func BenchmarkModels(b *testing.B) {
+	c := color.NRGBA{R: 0xaa, G: 0x55, B: 0xaa, A: 0x80}
+	b.Run("RGBA8Model", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			pxl.RGBA8Model.Convert(c)
+		}
+	})
+	b.Run("RGBA32Model", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			pxl.RGBA32Model.Convert(c)
+		}
+	})
+	b.Run("Gray8Model", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			pxl.Gray8Model.Convert(c)
+		}
+	})
+}
@@ -0,0 +1,145 @@
+package pxl
+
+import (
+	"image"
+	"image/color"
+)
+
+// A Mono is a 1-bit monochrome color, either white (true) or black (false).
+// Colors converted into Mono are thresholded at half of their luminance.
+type Mono bool
+
+// Returns the alpha-premultiplied red, green, blue and alpha values
+// for the color. Each value ranges within [0, 0xffff], but is represented
+// by a uint32 so that multiplying by a blend factor up to 0xffff will not
+// overflow.
+//
+// An alpha-premultiplied color component c has been scaled by alpha (a),
+// so has valid values 0 <= c <= a.
+func (c Mono) RGBA() (r, g, b, a uint32) {
+	if c {
+		r, g, b = 0x0000ffff, 0x0000ffff, 0x0000ffff
+	}
+	a = 0x0000ffff
+	return
+}
+
+// Returns the hexadecimal code representing the RGBA color.
+func (c Mono) Hex() string {
+	if c {
+		return "ffffffff"
+	}
+	return "000000ff"
+}
+
+// A BitOrder is the order in which the pixels of a [MonoImage] are packed into each byte.
+type BitOrder int
+
+const (
+	MSBFirst BitOrder = iota // the leftmost pixel is stored in the most significant bit
+	LSBFirst                 // the leftmost pixel is stored in the least significant bit
+)
+
+// A MonoImage is an in-memory image whose pixels are [Mono] colors, packed 8 pixels per byte.
+// Its backing buffer is exactly the representation expected by most e-ink displays and
+// thermal printers, so it can be uploaded without copying. A set bit is a white pixel.
+type MonoImage struct {
+	// Pix holds the image's pixels, row by row. Each row starts on a byte boundary,
+	// and unused bits at the end of a row are zero.
+	Pix []uint8
+	// Stride is the Pix stride (in bytes) between vertically adjacent pixels,
+	// including any padding at the end of each row.
+	Stride int
+	// Rect is the image's bounds.
+	Rect image.Rectangle
+	// Order is the order in which pixels are packed into each byte.
+	Order BitOrder
+	// Offset is the number of bits, within [0, 8), that precede the pixel at Rect.Min.X in the first byte
+	// of each row. It is zero for images created with [NewMonoImage], and lets sub-images start in the middle of a byte.
+	Offset int
+}
+
+// Returns a new MonoImage with the given bounds and bit order, whose rows are
+// padded to a multiple of align bytes. An align of 1 pads rows to the next byte.
+// Every pixel is initialized to black.
+func NewMonoImage(r image.Rectangle, order BitOrder, align int) *MonoImage {
+	align = max(align, 1)
+	stride := (r.Dx() + 7) / 8
+	stride = (stride + align - 1) / align * align
+	return &MonoImage{
+		Pix:    make([]uint8, stride*r.Dy()),
+		Stride: stride,
+		Rect:   r,
+		Order:  order,
+	}
+}
+
+// Returns the domain for which At can return non-zero color.
+func (p *MonoImage) Bounds() image.Rectangle {
+	return p.Rect
+}
+
+// Returns the color model of the image.
+func (p *MonoImage) ColorModel() color.Model {
+	return MonoModel
+}
+
+// Returns the color of the pixel at (x, y).
+// Pixels outside of the image's bounds are black.
+func (p *MonoImage) At(x, y int) color.Color {
+	return p.Get(x, y)
+}
+
+// Returns the color of the pixel at (x, y).
+// Pixels outside of the image's bounds are black.
+func (p *MonoImage) Get(x, y int) Mono {
+	if !(image.Point{x, y}.In(p.Rect)) {
+		return false
+	}
+	return p.Pix[p.PixOffset(x, y)]&p.bit(x) != 0
+}
+
+// Sets the color of the pixel at (x, y).
+// Pixels outside of the image's bounds are left untouched.
+func (p *MonoImage) Set(x, y int, c Mono) {
+	if !(image.Point{x, y}.In(p.Rect)) {
+		return
+	}
+	i := p.PixOffset(x, y)
+	if c {
+		p.Pix[i] |= p.bit(x)
+	} else {
+		p.Pix[i] &^= p.bit(x)
+	}
+}
+
+// Returns the index of the byte of Pix that holds the pixel at (x, y).
+func (p *MonoImage) PixOffset(x, y int) int {
+	return (y-p.Rect.Min.Y)*p.Stride + (x-p.Rect.Min.X+p.Offset)/8
+}
+
+// Returns the mask of the bit that holds the pixel at x within its byte.
+func (p *MonoImage) bit(x int) uint8 {
+	n := (x - p.Rect.Min.X + p.Offset) % 8
+	if p.Order == LSBFirst {
+		return 1 << n
+	}
+	return 0x80 >> n
+}
+
+// Returns an image representing the portion of the image visible through r.
+// The returned image shares pixels with the original image.
+func (p *MonoImage) SubImage(r image.Rectangle) Image[Mono] {
+	r = r.Intersect(p.Rect)
+	if r.Empty() {
+		return &MonoImage{Order: p.Order}
+	}
+	i := p.PixOffset(r.Min.X, r.Min.Y)
+	return &MonoImage{
+		Pix:    p.Pix[i:],
+		Stride: p.Stride,
+		Rect:   r,
+		Order:  p.Order,
+		Offset: (r.Min.X - p.Rect.Min.X + p.Offset) % 8,
+	}
+}
@@ -0,0 +1,173 @@
+package pxl_test
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"pxl"
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMono(t *testing.T) {
+	t.Parallel()
+	t.Run("implements the pxl color interface", func(t *testing.T) {
+		var v any = pxl.Mono(false)
+		_, ok := v.(pxl.Color)
+		assert.True(t, ok)
+	})
+	t.Run("does not exceed the expected number of bytes", func(t *testing.T) {
+		assert.Equal(t, 1, int(unsafe.Sizeof(pxl.Mono(false))))
+	})
+	t.Run("RGBA()", func(t *testing.T) {
+		r, g, b, a := pxl.Mono(true).RGBA()
+		assert.Equal(t, [4]uint32{0xffff, 0xffff, 0xffff, 0xffff}, [4]uint32{r, g, b, a})
+		r, g, b, a = pxl.Mono(false).RGBA()
+		assert.Equal(t, [4]uint32{0x0000, 0x0000, 0x0000, 0xffff}, [4]uint32{r, g, b, a})
+	})
+	t.Run("Hex()", func(t *testing.T) {
+		assert.Equal(t, "ffffffff", pxl.Mono(true).Hex())
+		assert.Equal(t, "000000ff", pxl.Mono(false).Hex())
+		for _, c := range []pxl.Mono{false, true} {
+			p, err := pxl.ParseHex[pxl.Mono](c.Hex())
+			assert.NoError(t, err)
+			assert.Equal(t, c, p)
+		}
+		_, err := pxl.ParseHex[pxl.Mono]("808080ff")
+		assert.ErrorIs(t, err, pxl.ErrHexValue)
+	})
+	t.Run("MonoModel", func(t *testing.T) {
+		t.Run("converts to the correct value", func(t *testing.T) {
+			testCases := []struct {
+				c color.Color
+				e pxl.Mono
+			}{{c: color.White, e: true},
+				{c: color.Black, e: false},
+				{c: color.Transparent, e: false},
+				{c: pxl.Gray8(0x7f), e: false},
+				{c: pxl.Gray8(0x80), e: true},
+				{c: pxl.RGBA32{R: 0xff, G: 0xff, B: 0x00, A: 0xff}, e: true},
+				{c: pxl.RGBA32{R: 0x00, G: 0x00, B: 0xff, A: 0xff}, e: false}}
+			for _, testCase := range testCases {
+				t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+					assert.Equal(t, testCase.e, pxl.MonoModel.Convert(testCase.c))
+				})
+			}
+		})
+	})
+}
+
+func TestMonoImage(t *testing.T) {
+	t.Parallel()
+	t.Run("implements the pxl image interface", func(t *testing.T) {
+		var v any = pxl.NewMonoImage(image.Rect(0, 0, 1, 1), pxl.MSBFirst, 1)
+		_, ok := v.(pxl.Image[pxl.Mono])
+		assert.True(t, ok)
+	})
+	t.Run("pads rows to the alignment", func(t *testing.T) {
+		testCases := []struct {
+			w      int
+			align  int
+			stride int
+		}{{w: 1, align: 1, stride: 1},
+			{w: 8, align: 1, stride: 1},
+			{w: 9, align: 1, stride: 2},
+			{w: 9, align: 4, stride: 4},
+			{w: 33, align: 4, stride: 8},
+			{w: 0, align: 4, stride: 0},
+			{w: 5, align: 0, stride: 1}}
+		for _, testCase := range testCases {
+			t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+				img := pxl.NewMonoImage(image.Rect(0, 0, testCase.w, 3), pxl.MSBFirst, testCase.align)
+				assert.Equal(t, testCase.stride, img.Stride)
+				assert.Len(t, img.Pix, 3*testCase.stride)
+			})
+		}
+	})
+	t.Run("packs pixels in the bit order", func(t *testing.T) {
+		msb := pxl.NewMonoImage(image.Rect(10, 20, 20, 22), pxl.MSBFirst, 1)
+		lsb := pxl.NewMonoImage(image.Rect(10, 20, 20, 22), pxl.LSBFirst, 1)
+		for _, img := range []*pxl.MonoImage{msb, lsb} {
+			img.Set(10, 20, true)
+			img.Set(12, 20, true)
+			img.Set(19, 21, true)
+			img.Set(20, 21, true)
+		}
+		assert.Equal(t, []uint8{0xa0, 0x00, 0x00, 0x40}, msb.Pix)
+		assert.Equal(t, []uint8{0x05, 0x00, 0x00, 0x02}, lsb.Pix)
+		for _, img := range []*pxl.MonoImage{msb, lsb} {
+			assert.Equal(t, pxl.Mono(true), img.Get(12, 20))
+			assert.Equal(t, pxl.Mono(false), img.Get(11, 20))
+			assert.Equal(t, pxl.Mono(true), img.Get(19, 21))
+			assert.Equal(t, pxl.Mono(false), img.Get(20, 21))
+			img.Set(12, 20, false)
+			assert.Equal(t, pxl.Mono(false), img.Get(12, 20))
+			assert.Equal(t, pxl.Mono(true), img.Get(10, 20))
+		}
+	})
+	t.Run("addresses the pixels of struct literals", func(t *testing.T) {
+		testCases := []struct {
+			r image.Rectangle
+			e []uint8
+		}{{r: image.Rect(16, 0, 26, 2), e: []uint8{0x80, 0x00, 0x00, 0x40}},
+			{r: image.Rect(-3, 0, 7, 2), e: []uint8{0x80, 0x00, 0x00, 0x40}},
+			{r: image.Rect(-12, -1, -2, 1), e: []uint8{0x80, 0x00, 0x00, 0x40}}}
+		for _, testCase := range testCases {
+			t.Run(fmt.Sprintf("%+v", testCase.r), func(t *testing.T) {
+				img := &pxl.MonoImage{Pix: make([]uint8, 4), Stride: 2, Rect: testCase.r}
+				img.Set(testCase.r.Min.X, testCase.r.Min.Y, true)
+				img.Set(testCase.r.Max.X-1, testCase.r.Max.Y-1, true)
+				assert.Equal(t, testCase.e, img.Pix)
+				assert.Equal(t, pxl.Mono(true), img.Get(testCase.r.Min.X, testCase.r.Min.Y))
+				assert.Equal(t, pxl.Mono(false), img.Get(testCase.r.Min.X+1, testCase.r.Min.Y))
+			})
+		}
+	})
+	t.Run("SubImage()", func(t *testing.T) {
+		t.Run("shares pixels with unaligned bounds", func(t *testing.T) {
+			img := pxl.NewMonoImage(image.Rect(0, 0, 20, 4), pxl.MSBFirst, 1)
+			sub := img.SubImage(image.Rect(3, 1, 13, 3))
+			assert.Equal(t, image.Rect(3, 1, 13, 3), sub.Bounds())
+			sub.Set(3, 1, true)
+			sub.Set(12, 2, true)
+			sub.Set(2, 1, true)
+			assert.Equal(t, pxl.Mono(true), img.Get(3, 1))
+			assert.Equal(t, pxl.Mono(true), img.Get(12, 2))
+			assert.Equal(t, pxl.Mono(false), img.Get(2, 1))
+			assert.Equal(t, []uint8{0x10, 0x00, 0x00}, img.Pix[3:6])
+			assert.Equal(t, []uint8{0x00, 0x08, 0x00}, img.Pix[6:9])
+			assert.Equal(t, pxl.Mono(true), sub.(*pxl.MonoImage).SubImage(image.Rect(12, 2, 13, 3)).Get(12, 2))
+		})
+		t.Run("carries the bit offset", func(t *testing.T) {
+			img := pxl.NewMonoImage(image.Rect(-5, 0, 20, 1), pxl.LSBFirst, 1)
+			sub := img.SubImage(image.Rect(-2, 0, 20, 1)).(*pxl.MonoImage)
+			assert.Equal(t, 3, sub.Offset)
+			assert.Equal(t, 2, sub.SubImage(image.Rect(5, 0, 20, 1)).(*pxl.MonoImage).Offset)
+			sub.Set(6, 0, true)
+			assert.Equal(t, []uint8{0x00, 0x08, 0x00, 0x00}, img.Pix)
+		})
+		t.Run("returns an empty image outside of the bounds", func(t *testing.T) {
+			img := pxl.NewMonoImage(image.Rect(0, 0, 8, 8), pxl.MSBFirst, 1)
+			assert.True(t, img.SubImage(image.Rect(10, 10, 20, 20)).Bounds().Empty())
+		})
+	})
+	t.Run("ColorModel()", func(t *testing.T) {
+		assert.Equal(t, pxl.MonoModel, pxl.NewMonoImage(image.Rect(0, 0, 1, 1), pxl.MSBFirst, 1).ColorModel())
+	})
+}
+
+func BenchmarkMonoImage(b *testing.B) {
+	img := pxl.NewMonoImage(image.Rect(0, 0, 256, 256), pxl.MSBFirst, 4)
+	b.Run("Get()", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			img.Get(i&0xff, i>>8&0xff)
+		}
+	})
+	b.Run("Set()", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			img.Set(i&0xff, i>>8&0xff, i&1 == 0)
+		}
+	})
+}
@@ -0,0 +1,232 @@
+package pxl
+
+import "fmt"
+
+// A BGRA32 is a 32-bit color represented by the additive RGBA color model.
+// Each channel is represented by 8 bits, stored in the order B, G, R, A.
+// BGRA32 is not alpha-premultiplied.
+type BGRA32 struct {
+	B, G, R, A uint8
+}
+
+// Returns the alpha-premultiplied red, green, blue and alpha values
+// for the color. Each value ranges within [0, 0xffff], but is represented
+// by a uint32 so that multiplying by a blend factor up to 0xffff will not
+// overflow.
+//
+// An alpha-premultiplied color component c has been scaled by alpha (a),
+// so has valid values 0 <= c <= a.
+func (c BGRA32) RGBA() (r, g, b, a uint32) {
+	a = uint32(c.A) * 0x00000101
+	r = uint32(c.R) * a / 0x000000ff
+	g = uint32(c.G) * a / 0x000000ff
+	b = uint32(c.B) * a / 0x000000ff
+	return
+}
+
+// Returns the hexadecimal code representing the RGBA color.
+// Like every color type, the code is in the order red, green, blue, alpha, regardless of the storage order.
+func (c BGRA32) Hex() string {
+	return fmt.Sprintf("%02x%02x%02x%02x", c.R, c.G, c.B, c.A)
+}
+
+// An ARGB32 is a 32-bit color represented by the additive RGBA color model.
+// Each channel is represented by 8 bits, stored in the order A, R, G, B.
+// ARGB32 is not alpha-premultiplied.
+type ARGB32 struct {
+	A, R, G, B uint8
+}
+
+// Returns the alpha-premultiplied red, green, blue and alpha values
+// for the color. Each value ranges within [0, 0xffff], but is represented
+// by a uint32 so that multiplying by a blend factor up to 0xffff will not
+// overflow.
+//
+// An alpha-premultiplied color component c has been scaled by alpha (a),
+// so has valid values 0 <= c <= a.
+func (c ARGB32) RGBA() (r, g, b, a uint32) {
+	a = uint32(c.A) * 0x00000101
+	r = uint32(c.R) * a / 0x000000ff
+	g = uint32(c.G) * a / 0x000000ff
+	b = uint32(c.B) * a / 0x000000ff
+	return
+}
+
+// Returns the hexadecimal code representing the RGBA color.
+// Like every color type, the code is in the order red, green, blue, alpha, regardless of the storage order.
+func (c ARGB32) Hex() string {
+	return fmt.Sprintf("%02x%02x%02x%02x", c.R, c.G, c.B, c.A)
+}
+
+// An ABGR32 is a 32-bit color represented by the additive RGBA color model.
+// Each channel is represented by 8 bits, stored in the order A, B, G, R.
+// ABGR32 is not alpha-premultiplied.
+type ABGR32 struct {
+	A, B, G, R uint8
+}
+
+// Returns the alpha-premultiplied red, green, blue and alpha values
+// for the color. Each value ranges within [0, 0xffff], but is represented
+// by a uint32 so that multiplying by a blend factor up to 0xffff will not
+// overflow.
+//
+// An alpha-premultiplied color component c has been scaled by alpha (a),
+// so has valid values 0 <= c <= a.
+func (c ABGR32) RGBA() (r, g, b, a uint32) {
+	a = uint32(c.A) * 0x00000101
+	r = uint32(c.R) * a / 0x000000ff
+	g = uint32(c.G) * a / 0x000000ff
+	b = uint32(c.B) * a / 0x000000ff
+	return
+}
+
+// Returns the hexadecimal code representing the RGBA color.
+// Like every color type, the code is in the order red, green, blue, alpha, regardless of the storage order.
+func (c ABGR32) Hex() string {
+	return fmt.Sprintf("%02x%02x%02x%02x", c.R, c.G, c.B, c.A)
+}
+
+// A BGRA64 is a 64-bit color represented by the additive RGBA color model.
+// Each channel is represented by 16 bits, stored in the order B, G, R, A.
+// BGRA64 is not alpha-premultiplied.
+type BGRA64 struct {
+	B, G, R, A uint16
+}
+
+// Returns the alpha-premultiplied red, green, blue and alpha values
+// for the color. Each value ranges within [0, 0xffff], but is represented
+// by a uint32 so that multiplying by a blend factor up to 0xffff will not
+// overflow.
+//
+// An alpha-premultiplied color component c has been scaled by alpha (a),
+// so has valid values 0 <= c <= a.
+func (c BGRA64) RGBA() (r, g, b, a uint32) {
+	a = uint32(c.A)
+	r = uint32(c.R) * a / 0x0000ffff
+	g = uint32(c.G) * a / 0x0000ffff
+	b = uint32(c.B) * a / 0x0000ffff
+	return
+}
+
+// Returns the hexadecimal code representing the RGBA color.
+// Like every color type, the code is in the order red, green, blue, alpha, regardless of the storage order.
+func (c BGRA64) Hex() string {
+	return fmt.Sprintf("%04x%04x%04x%04x", c.R, c.G, c.B, c.A)
+}
+
+// An ARGB64 is a 64-bit color represented by the additive RGBA color model.
+// Each channel is represented by 16 bits, stored in the order A, R, G, B.
+// ARGB64 is not alpha-premultiplied.
+type ARGB64 struct {
+	A, R, G, B uint16
+}
+
+// Returns the alpha-premultiplied red, green, blue and alpha values
+// for the color. Each value ranges within [0, 0xffff], but is represented
+// by a uint32 so that multiplying by a blend factor up to 0xffff will not
+// overflow.
+//
+// An alpha-premultiplied color component c has been scaled by alpha (a),
+// so has valid values 0 <= c <= a.
+func (c ARGB64) RGBA() (r, g, b, a uint32) {
+	a = uint32(c.A)
+	r = uint32(c.R) * a / 0x0000ffff
+	g = uint32(c.G) * a / 0x0000ffff
+	b = uint32(c.B) * a / 0x0000ffff
+	return
+}
+
+// Returns the hexadecimal code representing the RGBA color.
+// Like every color type, the code is in the order red, green, blue, alpha, regardless of the storage order.
+func (c ARGB64) Hex() string {
+	return fmt.Sprintf("%04x%04x%04x%04x", c.R, c.G, c.B, c.A)
+}
+
+// An ABGR64 is a 64-bit color represented by the additive RGBA color model.
+// Each channel is represented by 16 bits, stored in the order A, B, G, R.
+// ABGR64 is not alpha-premultiplied.
+type ABGR64 struct {
+	A, B, G, R uint16
+}
+
+// Returns the alpha-premultiplied red, green, blue and alpha values
+// for the color. Each value ranges within [0, 0xffff], but is represented
+// by a uint32 so that multiplying by a blend factor up to 0xffff will not
+// overflow.
+//
+// An alpha-premultiplied color component c has been scaled by alpha (a),
+// so has valid values 0 <= c <= a.
+func (c ABGR64) RGBA() (r, g, b, a uint32) {
+	a = uint32(c.A)
+	r = uint32(c.R) * a / 0x0000ffff
+	g = uint32(c.G) * a / 0x0000ffff
+	b = uint32(c.B) * a / 0x0000ffff
+	return
+}
+
+// Returns the hexadecimal code representing the RGBA color.
+// Like every color type, the code is in the order red, green, blue, alpha, regardless of the storage order.
+func (c ABGR64) Hex() string {
+	return fmt.Sprintf("%04x%04x%04x%04x", c.R, c.G, c.B, c.A)
+}
+
+// A ChannelOrder is the order in which the four channels of a pixel are stored in memory.
+type ChannelOrder int
+
+const (
+	OrderRGBA ChannelOrder = iota // red, green, blue, alpha, as used by RGBA32 and image.NRGBA
+	OrderBGRA                     // blue, green, red, alpha, as used by Windows bitmaps and by Cairo on little-endian machines
+	OrderARGB                     // alpha, red, green, blue, as used by Cairo on big-endian machines
+	OrderABGR                     // alpha, blue, green, red, as used by 0xRRGGBBAA words stored little-endian
+)
+
+// The position in memory of the red, green, blue and alpha channels of each order.
+var channelPositions = [...][4]int{
+	OrderRGBA: {0, 1, 2, 3},
+	OrderBGRA: {2, 1, 0, 3},
+	OrderARGB: {1, 2, 3, 0},
+	OrderABGR: {3, 2, 1, 0},
+}
+
+// Reorders the channels of the 32-bit pixels of src, stored in the order from, into dst,
+// in the order to, and returns the number of pixels reordered, which is the minimum of
+// len(dst)/4 and len(src)/4. dst and src may be the same slice, to reorder in place.
+// Like the color types, the pixels are not premultiplied or unpremultiplied.
+func Swizzle32(dst, src []byte, from, to ChannelOrder) int {
+	n := min(len(dst), len(src)) / 4
+	p := swizzle(from, to)
+	for i := 0; i < 4*n; i += 4 {
+		s := [4]byte(src[i : i+4])
+		dst[i+0] = s[p[0]]
+		dst[i+1] = s[p[1]]
+		dst[i+2] = s[p[2]]
+		dst[i+3] = s[p[3]]
+	}
+	return n
+}
+
+// Reorders the channels of the 64-bit pixels of src, stored in the order from, into dst,
+// in the order to, and returns the number of pixels reordered, which is the minimum of
+// len(dst)/8 and len(src)/8. Each channel holds two bytes, whose order is preserved.
+// dst and src may be the same slice, to reorder in place.
+func Swizzle64(dst, src []byte, from, to ChannelOrder) int {
+	n := min(len(dst), len(src)) / 8
+	p := swizzle(from, to)
+	for i := 0; i < 8*n; i += 8 {
+		s := [8]byte(src[i : i+8])
+		for j, k := range p {
+			dst[i+2*j] = s[2*k]
+			dst[i+2*j+1] = s[2*k+1]
+		}
+	}
+	return n
+}
+
+// Returns, for each position of the order to, the position of the same channel in the order from.
+func swizzle(from, to ChannelOrder) [4]int {
+	var p [4]int
+	for ch, pos := range channelPositions[to] {
+		p[pos] = channelPositions[from][ch]
+	}
+	return p
+}
@@ -0,0 +1,164 @@
+package pxl_test
+
+import (
+	"fmt"
+	"pxl"
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChannelOrderColors(t *testing.T) {
+	t.Parallel()
+	rgba32 := pxl.RGBA32{R: 0x12, G: 0x34, B: 0x56, A: 0x78}
+	rgba64 := pxl.RGBA64{R: 0x1234, G: 0x5678, B: 0x9abc, A: 0xdef0}
+	testCases := []struct {
+		c      pxl.Color
+		e      pxl.Color
+		memory []byte
+	}{{c: pxl.BGRA32{R: 0x12, G: 0x34, B: 0x56, A: 0x78}, e: rgba32, memory: []byte{0x56, 0x34, 0x12, 0x78}},
+		{c: pxl.ARGB32{R: 0x12, G: 0x34, B: 0x56, A: 0x78}, e: rgba32, memory: []byte{0x78, 0x12, 0x34, 0x56}},
+		{c: pxl.ABGR32{R: 0x12, G: 0x34, B: 0x56, A: 0x78}, e: rgba32, memory: []byte{0x78, 0x56, 0x34, 0x12}},
+		{c: pxl.BGRA64{R: 0x1234, G: 0x5678, B: 0x9abc, A: 0xdef0}, e: rgba64},
+		{c: pxl.ARGB64{R: 0x1234, G: 0x5678, B: 0x9abc, A: 0xdef0}, e: rgba64},
+		{c: pxl.ABGR64{R: 0x1234, G: 0x5678, B: 0x9abc, A: 0xdef0}, e: rgba64}}
+	for _, testCase := range testCases {
+		t.Run(fmt.Sprintf("%T", testCase.c), func(t *testing.T) {
+			t.Run("returns the same values as the RGBA type of the same depth", func(t *testing.T) {
+				r, g, b, a := testCase.c.RGBA()
+				er, eg, eb, ea := testCase.e.RGBA()
+				assert.Equal(t, [4]uint32{er, eg, eb, ea}, [4]uint32{r, g, b, a})
+				assert.Equal(t, testCase.e.Hex(), testCase.c.Hex())
+			})
+			t.Run("converts to and from the RGBA type of the same depth", func(t *testing.T) {
+				switch c := testCase.c.(type) {
+				case pxl.BGRA32:
+					assert.Equal(t, rgba32, pxl.Convert[pxl.RGBA32](c))
+					assert.Equal(t, c, pxl.BGRA32Model.Convert(rgba32))
+				case pxl.ARGB32:
+					assert.Equal(t, rgba32, pxl.Convert[pxl.RGBA32](c))
+					assert.Equal(t, c, pxl.ARGB32Model.Convert(rgba32))
+				case pxl.ABGR32:
+					assert.Equal(t, rgba32, pxl.Convert[pxl.RGBA32](c))
+					assert.Equal(t, c, pxl.ABGR32Model.Convert(rgba32))
+				case pxl.BGRA64:
+					assert.Equal(t, rgba64, pxl.Convert[pxl.RGBA64](c))
+					assert.Equal(t, c, pxl.BGRA64Model.Convert(rgba64))
+				case pxl.ARGB64:
+					assert.Equal(t, rgba64, pxl.Convert[pxl.RGBA64](c))
+					assert.Equal(t, c, pxl.ARGB64Model.Convert(rgba64))
+				case pxl.ABGR64:
+					assert.Equal(t, rgba64, pxl.Convert[pxl.RGBA64](c))
+					assert.Equal(t, c, pxl.ABGR64Model.Convert(rgba64))
+				}
+			})
+			t.Run("parses its hexadecimal code", func(t *testing.T) {
+				var c pxl.Color
+				var err error
+				switch testCase.c.(type) {
+				case pxl.BGRA32:
+					c, err = pxl.ParseHex[pxl.BGRA32](testCase.c.Hex())
+				case pxl.ARGB32:
+					c, err = pxl.ParseHex[pxl.ARGB32](testCase.c.Hex())
+				case pxl.ABGR32:
+					c, err = pxl.ParseHex[pxl.ABGR32](testCase.c.Hex())
+				case pxl.BGRA64:
+					c, err = pxl.ParseHex[pxl.BGRA64](testCase.c.Hex())
+				case pxl.ARGB64:
+					c, err = pxl.ParseHex[pxl.ARGB64](testCase.c.Hex())
+				case pxl.ABGR64:
+					c, err = pxl.ParseHex[pxl.ABGR64](testCase.c.Hex())
+				}
+				assert.NoError(t, err)
+				assert.Equal(t, testCase.c, c)
+			})
+			if testCase.memory != nil {
+				t.Run("stores its channels in order", func(t *testing.T) {
+					var memory [4]byte
+					switch c := testCase.c.(type) {
+					case pxl.BGRA32:
+						memory = *(*[4]byte)(unsafe.Pointer(&c))
+					case pxl.ARGB32:
+						memory = *(*[4]byte)(unsafe.Pointer(&c))
+					case pxl.ABGR32:
+						memory = *(*[4]byte)(unsafe.Pointer(&c))
+					}
+					assert.Equal(t, testCase.memory, memory[:])
+				})
+			}
+		})
+	}
+}
+
+func TestSwizzle32(t *testing.T) {
+	t.Parallel()
+	pixels := map[pxl.ChannelOrder][]byte{
+		pxl.OrderRGBA: {0x12, 0x34, 0x56, 0x78, 0x9a, 0xbc, 0xde, 0xf0},
+		pxl.OrderBGRA: {0x56, 0x34, 0x12, 0x78, 0xde, 0xbc, 0x9a, 0xf0},
+		pxl.OrderARGB: {0x78, 0x12, 0x34, 0x56, 0xf0, 0x9a, 0xbc, 0xde},
+		pxl.OrderABGR: {0x78, 0x56, 0x34, 0x12, 0xf0, 0xde, 0xbc, 0x9a},
+	}
+	for from, src := range pixels {
+		for to, e := range pixels {
+			t.Run(fmt.Sprintf("from %d to %d", from, to), func(t *testing.T) {
+				dst := make([]byte, len(src))
+				assert.Equal(t, 2, pxl.Swizzle32(dst, src, from, to))
+				assert.Equal(t, e, dst)
+				inPlace := append([]byte(nil), src...)
+				pxl.Swizzle32(inPlace, inPlace, from, to)
+				assert.Equal(t, e, inPlace)
+			})
+		}
+	}
+	t.Run("stops at the shorter slice", func(t *testing.T) {
+		dst := make([]byte, 7)
+		assert.Equal(t, 1, pxl.Swizzle32(dst, pixels[pxl.OrderRGBA], pxl.OrderRGBA, pxl.OrderBGRA))
+		assert.Equal(t, []byte{0x56, 0x34, 0x12, 0x78, 0x00, 0x00, 0x00}, dst)
+	})
+}
+
+// Not parallel, as testing.AllocsPerRun cannot run during parallel tests.
+func TestSwizzleAllocs(t *testing.T) {
+	buf := make([]byte, 1024)
+	assert.Equal(t, 0.0, testing.AllocsPerRun(10, func() {
+		pxl.Swizzle32(buf, buf, pxl.OrderRGBA, pxl.OrderBGRA)
+		pxl.Swizzle64(buf, buf, pxl.OrderRGBA, pxl.OrderABGR)
+	}))
+}
+
+func TestSwizzle64(t *testing.T) {
+	t.Parallel()
+	t.Run("reorders two-byte channels", func(t *testing.T) {
+		src := []byte{0x12, 0x34, 0x56, 0x78, 0x9a, 0xbc, 0xde, 0xf0}
+		dst := make([]byte, 8)
+		assert.Equal(t, 1, pxl.Swizzle64(dst, src, pxl.OrderRGBA, pxl.OrderARGB))
+		assert.Equal(t, []byte{0xde, 0xf0, 0x12, 0x34, 0x56, 0x78, 0x9a, 0xbc}, dst)
+		assert.Equal(t, 1, pxl.Swizzle64(dst, dst, pxl.OrderARGB, pxl.OrderBGRA))
+		assert.Equal(t, []byte{0x9a, 0xbc, 0x56, 0x78, 0x12, 0x34, 0xde, 0xf0}, dst)
+	})
+	t.Run("matches the memory layout of the color types", func(t *testing.T) {
+		c := pxl.RGBA64{R: 0x1234, G: 0x5678, B: 0x9abc, A: 0xdef0}
+		src := (*[8]byte)(unsafe.Pointer(&c))[:]
+		var e pxl.ABGR64
+		dst := (*[8]byte)(unsafe.Pointer(&e))[:]
+		pxl.Swizzle64(dst, src, pxl.OrderRGBA, pxl.OrderABGR)
+		assert.Equal(t, pxl.ABGR64{R: 0x1234, G: 0x5678, B: 0x9abc, A: 0xdef0}, e)
+	})
+}
+
+func BenchmarkSwizzle(b *testing.B) {
+	buf := make([]byte, 4*1920*1080)
+	b.Run("Swizzle32()", func(b *testing.B) {
+		b.SetBytes(int64(len(buf)))
+		for i := 0; i < b.N; i++ {
+			pxl.Swizzle32(buf, buf, pxl.OrderRGBA, pxl.OrderBGRA)
+		}
+	})
+	b.Run("Swizzle64()", func(b *testing.B) {
+		b.SetBytes(int64(len(buf)))
+		for i := 0; i < b.N; i++ {
+			pxl.Swizzle64(buf, buf, pxl.OrderRGBA, pxl.OrderBGRA)
+		}
+	})
+}
@@ -0,0 +1,167 @@
+package pxl
+
+import (
+	"image"
+	"image/color"
+)
+
+// An RGBA8Image is an in-memory image whose pixels are [RGBA8] colors.
+// Its backing buffer is exactly the packed representation of its pixels,
+// one byte per pixel, so it can be uploaded or serialized without copying.
+type RGBA8Image struct {
+	// Pix holds the image's pixels as packed `rrggbbaa` bytes. The pixel at
+	// (x, y) is stored at Pix[(y-Rect.Min.Y)*Stride + (x-Rect.Min.X)].
+	Pix []uint8
+	// Stride is the Pix stride (in bytes) between vertically adjacent pixels.
+	Stride int
+	// Rect is the image's bounds.
+	Rect image.Rectangle
+}
+
+// Returns a new RGBA8Image with the given bounds.
+// Every pixel is initialized to transparent black.
+func NewRGBA8Image(r image.Rectangle) *RGBA8Image {
+	w, h := r.Dx(), r.Dy()
+	return &RGBA8Image{
+		Pix:    make([]uint8, w*h),
+		Stride: w,
+		Rect:   r,
+	}
+}
+
+// Returns the domain for which At can return non-zero color.
+func (p *RGBA8Image) Bounds() image.Rectangle {
+	return p.Rect
+}
+
+// Returns the color model of the image.
+func (p *RGBA8Image) ColorModel() color.Model {
+	return RGBA8Model
+}
+
+// Returns the color of the pixel at (x, y).
+// Pixels outside of the image's bounds are transparent black.
+func (p *RGBA8Image) At(x, y int) color.Color {
+	return p.Get(x, y)
+}
+
+// Returns the color of the pixel at (x, y).
+// Pixels outside of the image's bounds are transparent black.
+func (p *RGBA8Image) Get(x, y int) RGBA8 {
+	if !(image.Point{x, y}.In(p.Rect)) {
+		return 0
+	}
+	return RGBA8(p.Pix[p.PixOffset(x, y)])
+}
+
+// Sets the color of the pixel at (x, y).
+// Pixels outside of the image's bounds are left untouched.
+func (p *RGBA8Image) Set(x, y int, c RGBA8) {
+	if !(image.Point{x, y}.In(p.Rect)) {
+		return
+	}
+	p.Pix[p.PixOffset(x, y)] = uint8(c)
+}
+
+// Returns the index of the byte of Pix that corresponds to the pixel at (x, y).
+func (p *RGBA8Image) PixOffset(x, y int) int {
+	return (y-p.Rect.Min.Y)*p.Stride + (x - p.Rect.Min.X)
+}
+
+// Returns an image representing the portion of the image visible through r.
+// The returned image shares pixels with the original image.
+func (p *RGBA8Image) SubImage(r image.Rectangle) Image[RGBA8] {
+	r = r.Intersect(p.Rect)
+	if r.Empty() {
+		return &RGBA8Image{}
+	}
+	i := p.PixOffset(r.Min.X, r.Min.Y)
+	return &RGBA8Image{
+		Pix:    p.Pix[i:],
+		Stride: p.Stride,
+		Rect:   r,
+	}
+}
+
+// An RGBA16Image is an in-memory image whose pixels are [RGBA16] colors.
+// Its backing buffer is exactly the packed representation of its pixels,
+// two big-endian bytes per pixel, so it can be uploaded or serialized without copying.
+type RGBA16Image struct {
+	// Pix holds the image's pixels as packed `rrrrgggg bbbbaaaa` byte pairs.
+	// The pixel at (x, y) starts at Pix[(y-Rect.Min.Y)*Stride + (x-Rect.Min.X)*2].
+	Pix []uint8
+	// Stride is the Pix stride (in bytes) between vertically adjacent pixels.
+	Stride int
+	// Rect is the image's bounds.
+	Rect image.Rectangle
+}
+
+// Returns a new RGBA16Image with the given bounds.
+// Every pixel is initialized to transparent black.
+func NewRGBA16Image(r image.Rectangle) *RGBA16Image {
+	w, h := r.Dx(), r.Dy()
+	return &RGBA16Image{
+		Pix:    make([]uint8, 2*w*h),
+		Stride: 2 * w,
+		Rect:   r,
+	}
+}
+
+// Returns the domain for which At can return non-zero color.
+func (p *RGBA16Image) Bounds() image.Rectangle {
+	return p.Rect
+}
+
+// Returns the color model of the image.
+func (p *RGBA16Image) ColorModel() color.Model {
+	return RGBA16Model
+}
+
+// Returns the color of the pixel at (x, y).
+// Pixels outside of the image's bounds are transparent black.
+func (p *RGBA16Image) At(x, y int) color.Color {
+	return p.Get(x, y)
+}
+
+// Returns the color of the pixel at (x, y).
+// Pixels outside of the image's bounds are transparent black.
+func (p *RGBA16Image) Get(x, y int) RGBA16 {
+	if !(image.Point{x, y}.In(p.Rect)) {
+		return 0
+	}
+	i := p.PixOffset(x, y)
+	s := p.Pix[i : i+2 : i+2] // Small cap improves performance, see https://golang.org/issue/27857
+	return RGBA16(s[0])<<8 | RGBA16(s[1])
+}
+
+// Sets the color of the pixel at (x, y).
+// Pixels outside of the image's bounds are left untouched.
+func (p *RGBA16Image) Set(x, y int, c RGBA16) {
+	if !(image.Point{x, y}.In(p.Rect)) {
+		return
+	}
+	i := p.PixOffset(x, y)
+	s := p.Pix[i : i+2 : i+2] // Small cap improves performance, see https://golang.org/issue/27857
+	s[0] = uint8(c >> 8)
+	s[1] = uint8(c)
+}
+
+// Returns the index of the first byte of Pix that corresponds to the pixel at (x, y).
+func (p *RGBA16Image) PixOffset(x, y int) int {
+	return (y-p.Rect.Min.Y)*p.Stride + (x-p.Rect.Min.X)*2
+}
+
+// Returns an image representing the portion of the image visible through r.
+// The returned image shares pixels with the original image.
+func (p *RGBA16Image) SubImage(r image.Rectangle) Image[RGBA16] {
+	r = r.Intersect(p.Rect)
+	if r.Empty() {
+		return &RGBA16Image{}
+	}
+	i := p.PixOffset(r.Min.X, r.Min.Y)
+	return &RGBA16Image{
+		Pix:    p.Pix[i:],
+		Stride: p.Stride,
+		Rect:   r,
+	}
+}
@@ -0,0 +1,195 @@
+package pxl_test
+
+import (
+	"fmt"
+	"image"
+	"pxl"
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRGBA8Image(t *testing.T) {
+	t.Parallel()
+	t.Run("implements the pxl image interface", func(t *testing.T) {
+		var v any = pxl.NewRGBA8Image(image.Rect(0, 0, 1, 1))
+		_, ok := v.(pxl.Image[pxl.RGBA8])
+		assert.True(t, ok)
+	})
+	t.Run("implements the std image interface", func(t *testing.T) {
+		var v any = pxl.NewRGBA8Image(image.Rect(0, 0, 1, 1))
+		_, ok := v.(image.Image)
+		assert.True(t, ok)
+	})
+	t.Run("stores exactly one byte per pixel", func(t *testing.T) {
+		img := pxl.NewRGBA8Image(image.Rect(0, 0, 7, 3))
+		assert.Len(t, img.Pix, 21)
+		assert.Equal(t, 7, img.Stride)
+	})
+	t.Run("Get()", func(t *testing.T) {
+		t.Run("returns the color that was set", func(t *testing.T) {
+			testCases := []pxl.RGBA8{pxl.RGBA8(0x00), pxl.RGBA8(0xff), pxl.RGBA8(0x9b)}
+			for _, testCase := range testCases {
+				t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+					img := pxl.NewRGBA8Image(image.Rect(-1, -1, 1, 1))
+					img.Set(0, -1, testCase)
+					assert.Equal(t, testCase, img.Get(0, -1))
+					assert.Equal(t, uint8(testCase), img.Pix[1])
+				})
+			}
+		})
+		t.Run("returns transparent black out of bounds", func(t *testing.T) {
+			img := pxl.NewRGBA8Image(image.Rect(0, 0, 1, 1))
+			img.Set(1, 1, pxl.RGBA8(0xff))
+			assert.Equal(t, pxl.RGBA8(0x00), img.Get(1, 1))
+		})
+	})
+	t.Run("SubImage()", func(t *testing.T) {
+		t.Run("shares pixels with the original image", func(t *testing.T) {
+			img := pxl.NewRGBA8Image(image.Rect(0, 0, 4, 4))
+			sub := img.SubImage(image.Rect(1, 1, 3, 3))
+			sub.Set(2, 2, pxl.RGBA8(0x9b))
+			assert.Equal(t, pxl.RGBA8(0x9b), img.Get(2, 2))
+		})
+	})
+}
+
+func BenchmarkRGBA8Image(b *testing.B) {
+	r := image.Rect(0, 0, 256, 256)
+	b.Run("memory", func(b *testing.B) {
+		b.Run("packed", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				img := pxl.NewRGBA8Image(r)
+				b.ReportMetric(float64(len(img.Pix)), "bytes/image")
+			}
+		})
+		b.Run("grid", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				img := pxl.NewImage[pxl.RGBA8](r)
+				b.ReportMetric(float64(len(img.Pix)*int(unsafe.Sizeof(img.Pix[0]))), "bytes/image")
+			}
+		})
+	})
+	packed, grid := pxl.NewRGBA8Image(r), pxl.NewImage[pxl.RGBA8](r)
+	b.Run("Get()", func(b *testing.B) {
+		b.Run("packed", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				packed.Get(i&0xff, (i>>8)&0xff)
+			}
+		})
+		b.Run("grid", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				grid.Get(i&0xff, (i>>8)&0xff)
+			}
+		})
+	})
+	b.Run("Set()", func(b *testing.B) {
+		b.Run("packed", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				packed.Set(i&0xff, (i>>8)&0xff, pxl.RGBA8(i))
+			}
+		})
+		b.Run("grid", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				grid.Set(i&0xff, (i>>8)&0xff, pxl.RGBA8(i))
+			}
+		})
+	})
+}
+
+func TestRGBA16Image(t *testing.T) {
+	t.Parallel()
+	t.Run("implements the pxl image interface", func(t *testing.T) {
+		var v any = pxl.NewRGBA16Image(image.Rect(0, 0, 1, 1))
+		_, ok := v.(pxl.Image[pxl.RGBA16])
+		assert.True(t, ok)
+	})
+	t.Run("implements the std image interface", func(t *testing.T) {
+		var v any = pxl.NewRGBA16Image(image.Rect(0, 0, 1, 1))
+		_, ok := v.(image.Image)
+		assert.True(t, ok)
+	})
+	t.Run("stores exactly two bytes per pixel", func(t *testing.T) {
+		img := pxl.NewRGBA16Image(image.Rect(0, 0, 7, 3))
+		assert.Len(t, img.Pix, 42)
+		assert.Equal(t, 14, img.Stride)
+	})
+	t.Run("Get()", func(t *testing.T) {
+		t.Run("returns the color that was set", func(t *testing.T) {
+			testCases := []pxl.RGBA16{pxl.RGBA16(0x0000), pxl.RGBA16(0xffff), pxl.RGBA16(0xa5af)}
+			for _, testCase := range testCases {
+				t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+					img := pxl.NewRGBA16Image(image.Rect(-1, -1, 1, 1))
+					img.Set(0, -1, testCase)
+					assert.Equal(t, testCase, img.Get(0, -1))
+				})
+			}
+		})
+		t.Run("stores pixels in big-endian order", func(t *testing.T) {
+			img := pxl.NewRGBA16Image(image.Rect(0, 0, 2, 1))
+			img.Set(1, 0, pxl.RGBA16(0xa5af))
+			assert.Equal(t, []uint8{0x00, 0x00, 0xa5, 0xaf}, img.Pix)
+		})
+		t.Run("returns transparent black out of bounds", func(t *testing.T) {
+			img := pxl.NewRGBA16Image(image.Rect(0, 0, 1, 1))
+			img.Set(1, 1, pxl.RGBA16(0xffff))
+			assert.Equal(t, pxl.RGBA16(0x0000), img.Get(1, 1))
+		})
+	})
+	t.Run("SubImage()", func(t *testing.T) {
+		t.Run("shares pixels with the original image", func(t *testing.T) {
+			img := pxl.NewRGBA16Image(image.Rect(0, 0, 4, 4))
+			sub := img.SubImage(image.Rect(1, 1, 3, 3))
+			sub.Set(2, 2, pxl.RGBA16(0xa5af))
+			assert.Equal(t, pxl.RGBA16(0xa5af), img.Get(2, 2))
+		})
+	})
+}
+
+func BenchmarkRGBA16Image(b *testing.B) {
+	r := image.Rect(0, 0, 256, 256)
+	b.Run("memory", func(b *testing.B) {
+		b.Run("packed", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				img := pxl.NewRGBA16Image(r)
+				b.ReportMetric(float64(len(img.Pix)), "bytes/image")
+			}
+		})
+		b.Run("grid", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				img := pxl.NewImage[pxl.RGBA16](r)
+				b.ReportMetric(float64(len(img.Pix)*int(unsafe.Sizeof(img.Pix[0]))), "bytes/image")
+			}
+		})
+	})
+	packed, grid := pxl.NewRGBA16Image(r), pxl.NewImage[pxl.RGBA16](r)
+	b.Run("Get()", func(b *testing.B) {
+		b.Run("packed", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				packed.Get(i&0xff, (i>>8)&0xff)
+			}
+		})
+		b.Run("grid", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				grid.Get(i&0xff, (i>>8)&0xff)
+			}
+		})
+	})
+	b.Run("Set()", func(b *testing.B) {
+		b.Run("packed", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				packed.Set(i&0xff, (i>>8)&0xff, pxl.RGBA16(i))
+			}
+		})
+		b.Run("grid", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				grid.Set(i&0xff, (i>>8)&0xff, pxl.RGBA16(i))
+			}
+		})
+	})
+}
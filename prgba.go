@@ -0,0 +1,113 @@
+package pxl
+
+import "fmt"
+
+// A PRGBA32 is a 32-bit color represented by the additive RGBA color model.
+// Each channel is represented by 8 bits.
+// PRGBA32 is alpha-premultiplied, and is equivalent to the standard library's [image/color.RGBA].
+type PRGBA32 struct {
+	R, G, B, A uint8
+}
+
+// Returns the alpha-premultiplied red, green, blue and alpha values
+// for the color. Each value ranges within [0, 0xffff], but is represented
+// by a uint32 so that multiplying by a blend factor up to 0xffff will not
+// overflow.
+//
+// An alpha-premultiplied color component c has been scaled by alpha (a),
+// so has valid values 0 <= c <= a.
+func (c PRGBA32) RGBA() (r, g, b, a uint32) {
+	r = uint32(c.R) * 0x00000101
+	g = uint32(c.G) * 0x00000101
+	b = uint32(c.B) * 0x00000101
+	a = uint32(c.A) * 0x00000101
+	return
+}
+
+// Returns the hexadecimal code representing the alpha-premultiplied RGBA color.
+func (c PRGBA32) Hex() string {
+	return fmt.Sprintf("%02x%02x%02x%02x", c.R, c.G, c.B, c.A)
+}
+
+// A PRGBA64 is a 64-bit color represented by the additive RGBA color model.
+// Each channel is represented by 16 bits.
+// PRGBA64 is alpha-premultiplied, and is equivalent to the standard library's [image/color.RGBA64].
+type PRGBA64 struct {
+	R, G, B, A uint16
+}
+
+// Returns the alpha-premultiplied red, green, blue and alpha values
+// for the color. Each value ranges within [0, 0xffff], but is represented
+// by a uint32 so that multiplying by a blend factor up to 0xffff will not
+// overflow.
+//
+// An alpha-premultiplied color component c has been scaled by alpha (a),
+// so has valid values 0 <= c <= a.
+func (c PRGBA64) RGBA() (r, g, b, a uint32) {
+	return uint32(c.R), uint32(c.G), uint32(c.B), uint32(c.A)
+}
+
+// Returns the hexadecimal code representing the alpha-premultiplied RGBA color.
+func (c PRGBA64) Hex() string {
+	return fmt.Sprintf("%04x%04x%04x%04x", c.R, c.G, c.B, c.A)
+}
+
+// A PRGBA128 is a 128-bit color represented by the additive RGBA color model.
+// Each channel is represented by 32 bits.
+// PRGBA128 is alpha-premultiplied.
+type PRGBA128 struct {
+	R, G, B, A uint32
+}
+
+// Returns the alpha-premultiplied red, green, blue and alpha values
+// for the color. Each value ranges within [0, 0xffff], but is represented
+// by a uint32 so that multiplying by a blend factor up to 0xffff will not
+// overflow.
+//
+// An alpha-premultiplied color component c has been scaled by alpha (a),
+// so has valid values 0 <= c <= a.
+func (c PRGBA128) RGBA() (r, g, b, a uint32) {
+	return c.R >> 16, c.G >> 16, c.B >> 16, c.A >> 16
+}
+
+// Returns the hexadecimal code representing the alpha-premultiplied RGBA color.
+func (c PRGBA128) Hex() string {
+	return fmt.Sprintf("%08x%08x%08x%08x", c.R, c.G, c.B, c.A)
+}
+
+// A PRGBA256 is a 256-bit color represented by the additive RGBA color model.
+// Each channel is represented by 64 bits.
+// PRGBA256 is alpha-premultiplied.
+type PRGBA256 struct {
+	R, G, B, A uint64
+}
+
+// Returns the alpha-premultiplied red, green, blue and alpha values
+// for the color. Each value ranges within [0, 0xffff], but is represented
+// by a uint32 so that multiplying by a blend factor up to 0xffff will not
+// overflow.
+//
+// An alpha-premultiplied color component c has been scaled by alpha (a),
+// so has valid values 0 <= c <= a.
+func (c PRGBA256) RGBA() (r, g, b, a uint32) {
+	return uint32(c.R >> 48), uint32(c.G >> 48), uint32(c.B >> 48), uint32(c.A >> 48)
+}
+
+// Returns the hexadecimal code representing the alpha-premultiplied RGBA color.
+func (c PRGBA256) Hex() string {
+	return fmt.Sprintf("%016x%016x%016x%016x", c.R, c.G, c.B, c.A)
+}
+
+// Returns the wide representation of alpha-premultiplied channels, which each range within [0, max].
+// Channels that exceed alpha saturate.
+func unpremultiply(r, g, b, a, max uint64) wide {
+	if a == 0 {
+		return wide{}
+	}
+	return wide{r: mulDiv(r, wideMax, a), g: mulDiv(g, wideMax, a), b: mulDiv(b, wideMax, a), a: expand(a, max)}
+}
+
+// Returns the alpha-premultiplied channels of the wide color, each scaled to [0, max].
+func (w wide) premultiply(max uint64) (r, g, b, a uint64) {
+	return quantize(mulDiv(w.r, w.a, wideMax), max), quantize(mulDiv(w.g, w.a, wideMax), max), quantize(mulDiv(w.b, w.a, wideMax), max), quantize(w.a, max)
+}
@@ -0,0 +1,227 @@
+package pxl_test
+
+import (
+	"fmt"
+	"image/color"
+	"pxl"
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPRGBA32(t *testing.T) {
+	t.Parallel()
+	t.Run("implements the pxl color interface", func(t *testing.T) {
+		var v any = pxl.PRGBA32{}
+		_, ok := v.(pxl.Color)
+		assert.True(t, ok)
+	})
+	t.Run("does not exceed the expected number of bytes", func(t *testing.T) {
+		assert.Equal(t, 4, int(unsafe.Sizeof(pxl.PRGBA32{})))
+	})
+	t.Run("RGBA()", func(t *testing.T) {
+		t.Run("returns the same values as color.RGBA", func(t *testing.T) {
+			for a := 0; a <= 0xff; a += 5 {
+				for v := 0; v <= a; v += 3 {
+					c := pxl.PRGBA32{R: uint8(v), G: uint8(a - v), B: uint8(v / 2), A: uint8(a)}
+					r, g, b, a := c.RGBA()
+					er, eg, eb, ea := color.RGBA{R: c.R, G: c.G, B: c.B, A: c.A}.RGBA()
+					assert.Equal(t, [4]uint32{er, eg, eb, ea}, [4]uint32{r, g, b, a})
+				}
+			}
+		})
+	})
+	t.Run("Hex()", func(t *testing.T) {
+		t.Run("returns the correct value", func(t *testing.T) {
+			testCases := []struct {
+				c   pxl.PRGBA32
+				hex string
+			}{{c: pxl.PRGBA32{R: 0x00, G: 0x00, B: 0x00, A: 0x00}, hex: "00000000"},
+				{c: pxl.PRGBA32{R: 0xff, G: 0xff, B: 0xff, A: 0xff}, hex: "ffffffff"},
+				{c: pxl.PRGBA32{R: 0x40, G: 0x00, B: 0x20, A: 0x80}, hex: "40002080"}}
+			for _, testCase := range testCases {
+				t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+					assert.Equal(t, testCase.hex, testCase.c.Hex())
+					c, err := pxl.ParseHex[pxl.PRGBA32](testCase.hex)
+					assert.NoError(t, err)
+					assert.Equal(t, testCase.c, c)
+				})
+			}
+		})
+		t.Run("is rejected by ParseHex when a channel exceeds alpha", func(t *testing.T) {
+			_, err := pxl.ParseHex[pxl.PRGBA32]("81000080")
+			assert.ErrorIs(t, err, pxl.ErrHexValue)
+		})
+	})
+	t.Run("PRGBA32Model", func(t *testing.T) {
+		t.Run("converts to the correct value", func(t *testing.T) {
+			testCases := []struct {
+				c color.Color
+				e pxl.PRGBA32
+			}{{c: pxl.RGBA32{R: 0xff, G: 0x80, B: 0x00, A: 0x80}, e: pxl.PRGBA32{R: 0x80, G: 0x40, B: 0x00, A: 0x80}},
+				{c: pxl.RGBA32{R: 0xff, G: 0xff, B: 0xff, A: 0x00}, e: pxl.PRGBA32{}},
+				{c: color.RGBA{R: 0x10, G: 0x20, B: 0x30, A: 0x40}, e: pxl.PRGBA32{R: 0x10, G: 0x20, B: 0x30, A: 0x40}},
+				{c: pxl.Gray8(0x80), e: pxl.PRGBA32{R: 0x80, G: 0x80, B: 0x80, A: 0xff}}}
+			for _, testCase := range testCases {
+				t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+					assert.Equal(t, testCase.e, pxl.PRGBA32Model.Convert(testCase.c))
+				})
+			}
+		})
+		t.Run("round-trips every color through RGBA32", func(t *testing.T) {
+			for a := 0; a <= 0xff; a++ {
+				for v := 0; v <= a; v++ {
+					c := pxl.PRGBA32{R: uint8(v), G: uint8(a - v), B: uint8(v / 2), A: uint8(a)}
+					if c != pxl.Convert[pxl.PRGBA32](pxl.Convert[pxl.RGBA32](c)) {
+						assert.Fail(t, "round trip failed", "%+v", c)
+					}
+				}
+			}
+		})
+		t.Run("loses the precision of straight colors at low alpha", func(t *testing.T) {
+			// Premultiplying by alpha a leaves only a+1 distinct values per channel.
+			for _, a := range []int{0x00, 0x01, 0x02, 0x10, 0x80, 0xff} {
+				seen := map[pxl.RGBA32]bool{}
+				for v := 0; v <= 0xff; v++ {
+					c := pxl.RGBA32{R: uint8(v), A: uint8(a)}
+					seen[pxl.Convert[pxl.RGBA32](pxl.Convert[pxl.PRGBA32](c))] = true
+				}
+				assert.Len(t, seen, a+1, "alpha %#02x", a)
+			}
+			c := pxl.RGBA32{R: 0x12, G: 0x34, B: 0x56, A: 0x01}
+			assert.Equal(t, pxl.RGBA32{R: 0x00, G: 0x00, B: 0x00, A: 0x01}, pxl.Convert[pxl.RGBA32](pxl.Convert[pxl.PRGBA32](c)))
+			assert.Equal(t, c, pxl.Convert[pxl.RGBA32](pxl.Convert[pxl.PRGBA64](c)))
+		})
+	})
+}
+
+func TestPRGBA64(t *testing.T) {
+	t.Parallel()
+	t.Run("implements the pxl color interface", func(t *testing.T) {
+		var v any = pxl.PRGBA64{}
+		_, ok := v.(pxl.Color)
+		assert.True(t, ok)
+	})
+	t.Run("does not exceed the expected number of bytes", func(t *testing.T) {
+		assert.Equal(t, 8, int(unsafe.Sizeof(pxl.PRGBA64{})))
+	})
+	t.Run("RGBA()", func(t *testing.T) {
+		r, g, b, a := pxl.PRGBA64{R: 0x1234, G: 0x5678, B: 0x0000, A: 0x8000}.RGBA()
+		assert.Equal(t, [4]uint32{0x1234, 0x5678, 0x0000, 0x8000}, [4]uint32{r, g, b, a})
+	})
+	t.Run("Hex()", func(t *testing.T) {
+		c := pxl.PRGBA64{R: 0x1234, G: 0x5678, B: 0x0000, A: 0x8000}
+		assert.Equal(t, "1234567800008000", c.Hex())
+		p, err := pxl.ParseHex[pxl.PRGBA64](c.Hex())
+		assert.NoError(t, err)
+		assert.Equal(t, c, p)
+		_, err = pxl.ParseHex[pxl.PRGBA64]("8001000000008000")
+		assert.ErrorIs(t, err, pxl.ErrHexValue)
+	})
+	t.Run("PRGBA64Model", func(t *testing.T) {
+		t.Run("converts to the correct value", func(t *testing.T) {
+			testCases := []struct {
+				c color.Color
+				e pxl.PRGBA64
+			}{{c: pxl.RGBA64{R: 0xffff, G: 0x8000, B: 0x0000, A: 0x8000}, e: pxl.PRGBA64{R: 0x8000, G: 0x4000, B: 0x0000, A: 0x8000}},
+				{c: color.RGBA64{R: 0x1000, G: 0x2000, B: 0x3000, A: 0x4000}, e: pxl.PRGBA64{R: 0x1000, G: 0x2000, B: 0x3000, A: 0x4000}},
+				{c: pxl.PRGBA32{R: 0x40, G: 0x00, B: 0x20, A: 0x80}, e: pxl.PRGBA64{R: 0x4040, G: 0x0000, B: 0x2020, A: 0x8080}}}
+			for _, testCase := range testCases {
+				t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+					assert.Equal(t, testCase.e, pxl.PRGBA64Model.Convert(testCase.c))
+				})
+			}
+		})
+		t.Run("round-trips every RGBA32 color", func(t *testing.T) {
+			for a := 0; a <= 0xff; a++ {
+				for v := 0; v <= 0xff; v++ {
+					c := pxl.RGBA32{R: uint8(v), G: uint8(0xff - v), B: uint8(v / 2), A: uint8(a)}
+					if a == 0 {
+						c = pxl.RGBA32{}
+					}
+					if c != pxl.Convert[pxl.RGBA32](pxl.Convert[pxl.PRGBA64](c)) {
+						assert.Fail(t, "round trip failed", "%+v", c)
+					}
+				}
+			}
+		})
+	})
+}
+
+func TestPRGBA128(t *testing.T) {
+	t.Parallel()
+	t.Run("implements the pxl color interface", func(t *testing.T) {
+		var v any = pxl.PRGBA128{}
+		_, ok := v.(pxl.Color)
+		assert.True(t, ok)
+	})
+	t.Run("does not exceed the expected number of bytes", func(t *testing.T) {
+		assert.Equal(t, 16, int(unsafe.Sizeof(pxl.PRGBA128{})))
+	})
+	t.Run("RGBA()", func(t *testing.T) {
+		r, g, b, a := pxl.PRGBA128{R: 0x12345678, G: 0x00000000, B: 0x7fffffff, A: 0x80000000}.RGBA()
+		assert.Equal(t, [4]uint32{0x1234, 0x0000, 0x7fff, 0x8000}, [4]uint32{r, g, b, a})
+	})
+	t.Run("Hex()", func(t *testing.T) {
+		c := pxl.PRGBA128{R: 0x12345678, G: 0x00000000, B: 0x7fffffff, A: 0x80000000}
+		assert.Equal(t, "12345678000000007fffffff80000000", c.Hex())
+		p, err := pxl.ParseHex[pxl.PRGBA128](c.Hex())
+		assert.NoError(t, err)
+		assert.Equal(t, c, p)
+	})
+	t.Run("PRGBA128Model", func(t *testing.T) {
+		t.Run("round-trips RGBA64 colors at high alpha", func(t *testing.T) {
+			for a := 0x8000; a <= 0xffff; a += 0x0fff {
+				for v := 0; v <= 0xffff; v += 0x0101 {
+					c := pxl.RGBA64{R: uint16(v), G: uint16(0xffff - v), B: uint16(v / 3), A: uint16(a)}
+					assert.Equal(t, c, pxl.Convert[pxl.RGBA64](pxl.Convert[pxl.PRGBA128](c)))
+				}
+			}
+		})
+	})
+}
+
+func TestPRGBA256(t *testing.T) {
+	t.Parallel()
+	t.Run("implements the pxl color interface", func(t *testing.T) {
+		var v any = pxl.PRGBA256{}
+		_, ok := v.(pxl.Color)
+		assert.True(t, ok)
+	})
+	t.Run("does not exceed the expected number of bytes", func(t *testing.T) {
+		assert.Equal(t, 32, int(unsafe.Sizeof(pxl.PRGBA256{})))
+	})
+	t.Run("RGBA()", func(t *testing.T) {
+		r, g, b, a := pxl.PRGBA256{R: 0x123456789abcdef0, G: 0, B: 0x7fffffffffffffff, A: 0x8000000000000000}.RGBA()
+		assert.Equal(t, [4]uint32{0x1234, 0x0000, 0x7fff, 0x8000}, [4]uint32{r, g, b, a})
+	})
+	t.Run("Hex()", func(t *testing.T) {
+		c := pxl.PRGBA256{R: 0x123456789abcdef0, G: 0, B: 0x7fffffffffffffff, A: 0x8000000000000000}
+		assert.Equal(t, "123456789abcdef000000000000000007fffffffffffffff8000000000000000", c.Hex())
+		p, err := pxl.ParseHex[pxl.PRGBA256](c.Hex())
+		assert.NoError(t, err)
+		assert.Equal(t, c, p)
+	})
+	t.Run("PRGBA256Model", func(t *testing.T) {
+		t.Run("round-trips RGBA128 colors", func(t *testing.T) {
+			for _, a := range []uint32{0x00000001, 0x00010000, 0x80000000, 0xffffffff} {
+				c := pxl.RGBA128{R: 0xffffffff, G: 0x12345678, B: 0x00000001, A: a}
+				assert.Equal(t, c, pxl.Convert[pxl.RGBA128](pxl.Convert[pxl.PRGBA256](c)))
+			}
+		})
+	})
+}
+
+func BenchmarkPRGBA32(b *testing.B) {
+	b.Run("RGBA()", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			pxl.PRGBA32{R: uint8(i), A: 0xff}.RGBA()
+		}
+	})
+	b.Run("Convert()", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			pxl.Convert[pxl.RGBA32](pxl.PRGBA32{R: uint8(i) / 2, A: 0x80})
+		}
+	})
+}
@@ -0,0 +1,116 @@
+package pxl
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// An RGB565 is a 16-bit opaque color represented by the additive RGB color model,
+// as used by most embedded LCD controllers.
+// The channels are represented by 5, 6 and 5 bits, in the order `rrrrrggg gggbbbbb`.
+type RGB565 uint16
+
+// Returns the alpha-premultiplied red, green, blue and alpha values
+// for the color. Each value ranges within [0, 0xffff], but is represented
+// by a uint32 so that multiplying by a blend factor up to 0xffff will not
+// overflow.
+//
+// An alpha-premultiplied color component c has been scaled by alpha (a),
+// so has valid values 0 <= c <= a.
+func (c RGB565) RGBA() (r, g, b, a uint32) {
+	return wideOf(c).rgba()
+}
+
+// Returns the hexadecimal code representing the packed color.
+func (c RGB565) Hex() string {
+	return fmt.Sprintf("%04x", uint16(c))
+}
+
+// An RGB555 is a 16-bit opaque color represented by the additive RGB color model.
+// Each channel is represented by 5 bits, in the order `0rrrrrgg gggbbbbb`.
+type RGB555 uint16
+
+// Returns the alpha-premultiplied red, green, blue and alpha values
+// for the color. Each value ranges within [0, 0xffff], but is represented
+// by a uint32 so that multiplying by a blend factor up to 0xffff will not
+// overflow.
+//
+// An alpha-premultiplied color component c has been scaled by alpha (a),
+// so has valid values 0 <= c <= a.
+func (c RGB555) RGBA() (r, g, b, a uint32) {
+	return wideOf(c).rgba()
+}
+
+// Returns the hexadecimal code representing the packed color.
+func (c RGB555) Hex() string {
+	return fmt.Sprintf("%04x", uint16(c))
+}
+
+// An ARGB1555 is a 16-bit color represented by the additive RGBA color model.
+// Each color channel is represented by 5 bits, and alpha by 1 bit, in the order `arrrrrgg gggbbbbb`.
+// ARGB1555 is not alpha-premultiplied.
+type ARGB1555 uint16
+
+// Returns the alpha-premultiplied red, green, blue and alpha values
+// for the color. Each value ranges within [0, 0xffff], but is represented
+// by a uint32 so that multiplying by a blend factor up to 0xffff will not
+// overflow.
+//
+// An alpha-premultiplied color component c has been scaled by alpha (a),
+// so has valid values 0 <= c <= a.
+func (c ARGB1555) RGBA() (r, g, b, a uint32) {
+	return wideOf(c).rgba()
+}
+
+// Returns the hexadecimal code representing the packed color.
+func (c ARGB1555) Hex() string {
+	return fmt.Sprintf("%04x", uint16(c))
+}
+
+// An RGB444 is a 16-bit opaque color represented by the additive RGB color model.
+// Each channel is represented by 4 bits, in the order `0000rrrr ggggbbbb`.
+type RGB444 uint16
+
+// Returns the alpha-premultiplied red, green, blue and alpha values
+// for the color. Each value ranges within [0, 0xffff], but is represented
+// by a uint32 so that multiplying by a blend factor up to 0xffff will not
+// overflow.
+//
+// An alpha-premultiplied color component c has been scaled by alpha (a),
+// so has valid values 0 <= c <= a.
+func (c RGB444) RGBA() (r, g, b, a uint32) {
+	return wideOf(c).rgba()
+}
+
+// Returns the hexadecimal code representing the packed color.
+func (c RGB444) Hex() string {
+	return fmt.Sprintf("%04x", uint16(c))
+}
+
+// A Packed16 is a color type packed into 16 bits.
+type Packed16 interface {
+	Color
+	~uint16
+}
+
+// Encodes the colors of src into dst, two bytes per color, in the given byte order,
+// and returns the number of colors encoded, which is the minimum of len(src) and len(dst)/2.
+// SPI displays commonly expect [binary.BigEndian], while framebuffers of little-endian
+// processors expect [binary.LittleEndian].
+func EncodePacked16[T Packed16](dst []byte, src []T, order binary.ByteOrder) int {
+	n := min(len(src), len(dst)/2)
+	for i, c := range src[:n] {
+		order.PutUint16(dst[2*i:], uint16(c))
+	}
+	return n
+}
+
+// Decodes colors from src, two bytes per color, in the given byte order, into dst,
+// and returns the number of colors decoded, which is the minimum of len(dst) and len(src)/2.
+func DecodePacked16[T Packed16](dst []T, src []byte, order binary.ByteOrder) int {
+	n := min(len(dst), len(src)/2)
+	for i := range dst[:n] {
+		dst[i] = T(order.Uint16(src[2*i:]))
+	}
+	return n
+}
@@ -0,0 +1,200 @@
+package pxl_test
+
+import (
+	"encoding/binary"
+	"fmt"
+	"image/color"
+	"pxl"
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRGB565(t *testing.T) {
+	t.Parallel()
+	t.Run("implements the pxl color interface", func(t *testing.T) {
+		var v any = pxl.RGB565(0x0000)
+		_, ok := v.(pxl.Color)
+		assert.True(t, ok)
+	})
+	t.Run("does not exceed the expected number of bytes", func(t *testing.T) {
+		assert.Equal(t, 2, int(unsafe.Sizeof(pxl.RGB565(0x0000))))
+	})
+	t.Run("RGBA()", func(t *testing.T) {
+		t.Run("returns the correct values", func(t *testing.T) {
+			testCases := []struct {
+				c pxl.RGB565
+				r uint32
+				g uint32
+				b uint32
+				a uint32
+			}{{c: pxl.RGB565(0x0000), r: 0x00000000, g: 0x00000000, b: 0x00000000, a: 0x0000ffff},
+				{c: pxl.RGB565(0xffff), r: 0x0000ffff, g: 0x0000ffff, b: 0x0000ffff, a: 0x0000ffff},
+				{c: pxl.RGB565(0xf800), r: 0x0000ffff, g: 0x00000000, b: 0x00000000, a: 0x0000ffff},
+				{c: pxl.RGB565(0x07e0), r: 0x00000000, g: 0x0000ffff, b: 0x00000000, a: 0x0000ffff},
+				{c: pxl.RGB565(0x8410), r: 0x00008421, g: 0x00008208, b: 0x00008421, a: 0x0000ffff}}
+			for _, testCase := range testCases {
+				t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+					r, g, b, a := testCase.c.RGBA()
+					assert.Equal(t, testCase.r, r)
+					assert.Equal(t, testCase.g, g)
+					assert.Equal(t, testCase.b, b)
+					assert.Equal(t, testCase.a, a)
+				})
+			}
+		})
+	})
+	t.Run("Hex()", func(t *testing.T) {
+		assert.Equal(t, "f800", pxl.RGB565(0xf800).Hex())
+		c, err := pxl.ParseHex[pxl.RGB565]("07E0")
+		assert.NoError(t, err)
+		assert.Equal(t, pxl.RGB565(0x07e0), c)
+	})
+	t.Run("RGB565Model", func(t *testing.T) {
+		t.Run("converts to the correct value", func(t *testing.T) {
+			testCases := []struct {
+				c color.Color
+				e pxl.RGB565
+			}{{c: color.White, e: 0xffff},
+				{c: color.Black, e: 0x0000},
+				{c: pxl.RGBA32{R: 0xff, G: 0x80, B: 0x00, A: 0xff}, e: 0xfc00},
+				{c: pxl.RGBA32{R: 0x12, G: 0x34, B: 0x56, A: 0xff}, e: 0x11aa}}
+			for _, testCase := range testCases {
+				t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+					assert.Equal(t, testCase.e, pxl.RGB565Model.Convert(testCase.c))
+				})
+			}
+		})
+		t.Run("round-trips every color through RGBA32", func(t *testing.T) {
+			for i := 0; i <= 0xffff; i++ {
+				c := pxl.RGB565(i)
+				if c != pxl.Convert[pxl.RGB565](pxl.Convert[pxl.RGBA32](c)) {
+					assert.Fail(t, "round trip failed", "%+v", c)
+				}
+			}
+		})
+	})
+}
+
+func TestRGB555(t *testing.T) {
+	t.Parallel()
+	t.Run("implements the pxl color interface", func(t *testing.T) {
+		var v any = pxl.RGB555(0x0000)
+		_, ok := v.(pxl.Color)
+		assert.True(t, ok)
+	})
+	t.Run("RGBA()", func(t *testing.T) {
+		r, g, b, a := pxl.RGB555(0x7c1f).RGBA()
+		assert.Equal(t, [4]uint32{0xffff, 0x0000, 0xffff, 0xffff}, [4]uint32{r, g, b, a})
+	})
+	t.Run("Hex()", func(t *testing.T) {
+		assert.Equal(t, "7c1f", pxl.RGB555(0x7c1f).Hex())
+		_, err := pxl.ParseHex[pxl.RGB555]("fc1f")
+		assert.ErrorIs(t, err, pxl.ErrHexValue)
+	})
+	t.Run("RGB555Model", func(t *testing.T) {
+		assert.Equal(t, pxl.RGB555(0x7fff), pxl.RGB555Model.Convert(color.White))
+		for i := 0; i <= 0x7fff; i++ {
+			c := pxl.RGB555(i)
+			if c != pxl.Convert[pxl.RGB555](pxl.Convert[pxl.RGBA32](c)) {
+				assert.Fail(t, "round trip failed", "%+v", c)
+			}
+		}
+	})
+}
+
+func TestARGB1555(t *testing.T) {
+	t.Parallel()
+	t.Run("implements the pxl color interface", func(t *testing.T) {
+		var v any = pxl.ARGB1555(0x0000)
+		_, ok := v.(pxl.Color)
+		assert.True(t, ok)
+	})
+	t.Run("RGBA()", func(t *testing.T) {
+		r, g, b, a := pxl.ARGB1555(0xfc00).RGBA()
+		assert.Equal(t, [4]uint32{0xffff, 0x0000, 0x0000, 0xffff}, [4]uint32{r, g, b, a})
+		r, g, b, a = pxl.ARGB1555(0x7c00).RGBA()
+		assert.Equal(t, [4]uint32{0x0000, 0x0000, 0x0000, 0x0000}, [4]uint32{r, g, b, a})
+	})
+	t.Run("Hex()", func(t *testing.T) {
+		c, err := pxl.ParseHex[pxl.ARGB1555](pxl.ARGB1555(0xfc00).Hex())
+		assert.NoError(t, err)
+		assert.Equal(t, pxl.ARGB1555(0xfc00), c)
+	})
+	t.Run("ARGB1555Model", func(t *testing.T) {
+		assert.Equal(t, pxl.ARGB1555(0x0000), pxl.ARGB1555Model.Convert(color.Transparent))
+		assert.Equal(t, pxl.ARGB1555(0xffff), pxl.ARGB1555Model.Convert(color.White))
+		assert.Equal(t, pxl.ARGB1555(0xfc00), pxl.ARGB1555Model.Convert(pxl.RGBA32{R: 0xff, A: 0x80}))
+		assert.Equal(t, pxl.ARGB1555(0x7c00), pxl.ARGB1555Model.Convert(pxl.RGBA32{R: 0xff, A: 0x7f}))
+		for i := 0; i <= 0xffff; i++ {
+			c := pxl.ARGB1555(i)
+			if c != pxl.Convert[pxl.ARGB1555](pxl.Convert[pxl.RGBA32](c)) {
+				assert.Fail(t, "round trip failed", "%+v", c)
+			}
+		}
+	})
+}
+
+func TestRGB444(t *testing.T) {
+	t.Parallel()
+	t.Run("implements the pxl color interface", func(t *testing.T) {
+		var v any = pxl.RGB444(0x0000)
+		_, ok := v.(pxl.Color)
+		assert.True(t, ok)
+	})
+	t.Run("RGBA()", func(t *testing.T) {
+		r, g, b, a := pxl.RGB444(0x0f80).RGBA()
+		assert.Equal(t, [4]uint32{0xffff, 0x8888, 0x0000, 0xffff}, [4]uint32{r, g, b, a})
+	})
+	t.Run("Hex()", func(t *testing.T) {
+		assert.Equal(t, "0f80", pxl.RGB444(0x0f80).Hex())
+		_, err := pxl.ParseHex[pxl.RGB444]("1f80")
+		assert.ErrorIs(t, err, pxl.ErrHexValue)
+	})
+	t.Run("RGB444Model", func(t *testing.T) {
+		assert.Equal(t, pxl.RGB444(0x0fff), pxl.RGB444Model.Convert(color.White))
+		for i := 0; i <= 0x0fff; i++ {
+			c := pxl.RGB444(i)
+			assert.Equal(t, c, pxl.Convert[pxl.RGB444](pxl.Convert[pxl.RGBA16](c)))
+		}
+	})
+}
+
+func TestPacked16(t *testing.T) {
+	t.Parallel()
+	t.Run("EncodePacked16()", func(t *testing.T) {
+		src := []pxl.RGB565{0xf800, 0x07e0, 0x001f}
+		be := make([]byte, 6)
+		le := make([]byte, 6)
+		assert.Equal(t, 3, pxl.EncodePacked16(be, src, binary.BigEndian))
+		assert.Equal(t, 3, pxl.EncodePacked16(le, src, binary.LittleEndian))
+		assert.Equal(t, []byte{0xf8, 0x00, 0x07, 0xe0, 0x00, 0x1f}, be)
+		assert.Equal(t, []byte{0x00, 0xf8, 0xe0, 0x07, 0x1f, 0x00}, le)
+		short := make([]byte, 5)
+		assert.Equal(t, 2, pxl.EncodePacked16(short, src, binary.BigEndian))
+		assert.Equal(t, []byte{0xf8, 0x00, 0x07, 0xe0, 0x00}, short)
+	})
+	t.Run("DecodePacked16()", func(t *testing.T) {
+		dst := make([]pxl.ARGB1555, 3)
+		assert.Equal(t, 2, pxl.DecodePacked16(dst, []byte{0x00, 0xfc, 0x1f, 0x80, 0xff}, binary.LittleEndian))
+		assert.Equal(t, []pxl.ARGB1555{0xfc00, 0x801f, 0x0000}, dst)
+		assert.Equal(t, 2, pxl.DecodePacked16(dst, []byte{0xfc, 0x00, 0x80, 0x1f}, binary.BigEndian))
+		assert.Equal(t, []pxl.ARGB1555{0xfc00, 0x801f, 0x0000}, dst)
+	})
+}
+
+func BenchmarkPacked16(b *testing.B) {
+	src := make([]pxl.RGB565, 320*240)
+	buf := make([]byte, 2*len(src))
+	b.Run("EncodePacked16()", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			pxl.EncodePacked16(buf, src, binary.BigEndian)
+		}
+	})
+	b.Run("Convert()", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			pxl.Convert[pxl.RGB565](pxl.RGBA32{R: uint8(i), G: uint8(i >> 8), B: 0x80, A: 0xff})
+		}
+	})
+}
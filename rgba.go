@@ -33,6 +33,11 @@ func (c RGBA8) Hex() string {
 	return fmt.Sprintf("%02x", c)
 }
 
+// Returns the CSS representation of the RGBA color, in hexadecimal notation.
+func (c RGBA8) CSS() string {
+	return cssOf(wideOf(c), 0)
+}
+
 // An RGBA16 is a 16-bit color represented by the additive RGBA color model.
 // Each channel is represented by 4 bits, in the order `rrrrgggg bbbbaaaa`.
 // RGBA16 is not alpha-premultiplied.
@@ -62,6 +67,11 @@ func (c RGBA16) Hex() string {
 	return fmt.Sprintf("%04x", c)
 }
 
+// Returns the CSS representation of the RGBA color, in hexadecimal notation.
+func (c RGBA16) CSS() string {
+	return cssOf(wideOf(c), 0)
+}
+
 // An RGBA32 is a 32-bit color represented by the additive RGBA color model.
 // Each channel is represented by 8 bits.
 // RGBA32 is not alpha-premultiplied, and is equivalent to the standard library's [image.NRGBA].
@@ -89,6 +99,11 @@ func (c RGBA32) Hex() string {
 	return fmt.Sprintf("%02x%02x%02x%02x", c.R, c.G, c.B, c.A)
 }
 
+// Returns the CSS representation of the RGBA color, in hexadecimal notation.
+func (c RGBA32) CSS() string {
+	return cssOf(wideOf(c), 0)
+}
+
 // An RGBA64 is a 64-bit color represented by the additive RGBA color model.
 // Each channel is represented by 16 bits.
 // RGBA64 is not alpha-premultiplied, and is equivalent to the standard library's [image.NRGBA64].
@@ -116,6 +131,13 @@ func (c RGBA64) Hex() string {
 	return fmt.Sprintf("%04x%04x%04x%04x", c.R, c.G, c.B, c.A)
 }
 
+// Returns the CSS representation of the RGBA color.
+// Colors that are not exactly representable in hexadecimal notation
+// are formatted with the `rgb()` function.
+func (c RGBA64) CSS() string {
+	return cssOf(wideOf(c), 3)
+}
+
 // An RGBA128 is a 128-bit color represented by the additive RGBA color model.
 // Each channel is represented by 32 bits.
 // RGBA128 is not alpha-premultiplied.
@@ -143,6 +165,13 @@ func (c RGBA128) Hex() string {
 	return fmt.Sprintf("%08x%08x%08x%08x", c.R, c.G, c.B, c.A)
 }
 
+// Returns the CSS representation of the RGBA color.
+// Colors that are not exactly representable in hexadecimal notation
+// are formatted with the `rgb()` function.
+func (c RGBA128) CSS() string {
+	return cssOf(wideOf(c), 8)
+}
+
 // An RGBA256 is a 256-bit color represented by the additive RGBA color model.
 // Each channel is represented by 64 bits.
 // RGBA256 is not alpha-premultiplied.
@@ -169,3 +198,10 @@ func (c RGBA256) RGBA() (r, g, b, a uint32) {
 func (c RGBA256) Hex() string {
 	return fmt.Sprintf("%016x%016x%016x%016x", c.R, c.G, c.B, c.A)
 }
+
+// Returns the CSS representation of the RGBA color.
+// Colors that are not exactly representable in hexadecimal notation
+// are formatted with the `rgb()` function.
+func (c RGBA256) CSS() string {
+	return cssOf(wideOf(c), -1)
+}
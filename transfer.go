@@ -0,0 +1,191 @@
+package pxl
+
+import (
+	"math"
+	"sync"
+)
+
+// A TransferFunction is the nonlinear function that relates the values stored by a color,
+// such as the channels of an [RGBA32], to the linear light that they represent.
+// Blending, resizing and filtering are only physically correct on linear light, so that
+// encoded values should be decoded before such operations, and encoded again afterwards.
+//
+// Besides the exact functions, a TransferFunction provides 8- and 16-bit lookup tables,
+// which are built on first use and shared by every goroutine.
+type TransferFunction struct {
+	encode, decode func(float64) float64
+	lut8, lut16    lut
+}
+
+// Common transfer functions.
+var (
+	// SRGB is the piecewise transfer function of sRGB (IEC 61966-2-1), assumed by every color of this package.
+	SRGB = &TransferFunction{encode: linearToSRGB, decode: srgbToLinear}
+	// Rec709 is the opto-electronic transfer function of high-definition video (ITU-R BT.709).
+	Rec709 = &TransferFunction{encode: linearToRec709, decode: rec709ToLinear}
+)
+
+// Returns the pure power transfer function of the given gamma, such as 2.2 or 1.8.
+// Encoded values are decoded by raising them to the power of gamma.
+func Gamma(gamma float64) *TransferFunction {
+	return &TransferFunction{
+		encode: func(v float64) float64 {
+			return math.Copysign(math.Pow(math.Abs(v), 1/gamma), v)
+		},
+		decode: func(v float64) float64 {
+			return math.Copysign(math.Pow(math.Abs(v), gamma), v)
+		},
+	}
+}
+
+// Returns the encoded value of the linear value v.
+// Values outside of [0, 1] are extended by mirroring the function around zero.
+func (t *TransferFunction) Encode(v float64) float64 {
+	return t.encode(v)
+}
+
+// Returns the linear value of the encoded value v.
+// Values outside of [0, 1] are extended by mirroring the function around zero.
+func (t *TransferFunction) Decode(v float64) float64 {
+	return t.decode(v)
+}
+
+// Returns the linear value of the 8-bit encoded value v, using a lookup table.
+func (t *TransferFunction) Decode8(v uint8) float32 {
+	return t.lut8.get(t, 0xff).values[v]
+}
+
+// Returns the linear value of the 16-bit encoded value v, using a lookup table.
+func (t *TransferFunction) Decode16(v uint16) float32 {
+	return t.lut16.get(t, 0xffff).values[v]
+}
+
+// Returns the 8-bit encoded value of the linear value v, using a lookup table.
+// The result is that of Encode(v), rounded to the nearest 8-bit value.
+// Values outside of [0, 1] are clamped.
+func (t *TransferFunction) Encode8(v float32) uint8 {
+	return uint8(t.lut8.get(t, 0xff).search(v))
+}
+
+// Returns the 16-bit encoded value of the linear value v, using a lookup table.
+// The result is that of Encode(v), rounded to the nearest 16-bit value.
+// Values outside of [0, 1] are clamped.
+func (t *TransferFunction) Encode16(v float32) uint16 {
+	return uint16(t.lut16.get(t, 0xffff).search(v))
+}
+
+// A lut is a lazily built lookup table of a transfer function for integer encoded values within [0, max].
+type lut struct {
+	once sync.Once
+	// values holds the linear value of each encoded value.
+	values []float32
+	// thresholds holds, for each encoded value but the last, the linear value
+	// halfway to the next encoded value, above which the next value is nearer.
+	thresholds []float32
+	// buckets holds, for each of lutBuckets even steps of linear values, the number of thresholds
+	// below the step, which narrows the search of the thresholds to the values of a single step.
+	buckets []int32
+}
+
+// The number of even steps of linear values indexed by a lut.
+const lutBuckets = 4096
+
+// Returns the lookup table, after building it on first use.
+func (l *lut) get(t *TransferFunction, max int) *lut {
+	l.once.Do(func() {
+		l.values = make([]float32, max+1)
+		l.thresholds = make([]float32, max)
+		for i := range l.values {
+			l.values[i] = float32(t.decode(float64(i) / float64(max)))
+		}
+		for i := range l.thresholds {
+			l.thresholds[i] = float32(t.decode((float64(i) + 0.5) / float64(max)))
+		}
+		l.buckets = make([]int32, lutBuckets+1)
+		for i := range l.buckets {
+			l.buckets[i] = int32(l.searchRange(float32(i)/lutBuckets, 0, max))
+		}
+	})
+	return l
+}
+
+// Returns the encoded value nearest to the linear value v.
+func (l *lut) search(v float32) int {
+	switch {
+	case !(v > 0): // Also catches NaN.
+		return 0
+	case v >= 1:
+		return len(l.thresholds)
+	}
+	i := int(v * lutBuckets)
+	return l.searchRange(v, int(l.buckets[i]), int(l.buckets[i+1]))
+}
+
+// Returns the encoded value nearest to the linear value v, by binary search of the thresholds,
+// knowing that it ranges within [lo, hi].
+func (l *lut) searchRange(v float32, lo, hi int) int {
+	for lo < hi {
+		m := int(uint(lo+hi) >> 1)
+		if l.thresholds[m] <= v {
+			lo = m + 1
+		} else {
+			hi = m
+		}
+	}
+	return lo
+}
+
+// Constants of the Rec. 709 transfer function, at the precision given by ITU-R BT.2020
+// so that both of its segments meet.
+const (
+	rec709Alpha = 1.09929682680944
+	rec709Beta  = 0.018053968510807
+)
+
+// Converts a value from linear light to the Rec. 709 transfer function's encoded values.
+func linearToRec709(v float64) float64 {
+	if math.Abs(v) < rec709Beta {
+		return v * 4.5
+	}
+	return math.Copysign(rec709Alpha*math.Pow(math.Abs(v), 0.45)-(rec709Alpha-1), v)
+}
+
+// Converts a value from the Rec. 709 transfer function's encoded values to linear light.
+func rec709ToLinear(v float64) float64 {
+	if math.Abs(v) < rec709Beta*4.5 {
+		return v / 4.5
+	}
+	return math.Copysign(math.Pow((math.Abs(v)+(rec709Alpha-1))/rec709Alpha, 1/0.45), v)
+}
+
+// Returns the image decoded into linear light with the given transfer function,
+// so that operations such as blending and resizing can run in linear light.
+// Alpha is not encoded, so that it is copied as is.
+func Linearize(img Image[RGBA32], t *TransferFunction) *Grid[RGBAF32] {
+	r := img.Bounds()
+	dst := NewImage[RGBAF32](r)
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		row := dst.Pix[dst.PixOffset(r.Min.X, y):][:r.Dx()]
+		for x := range row {
+			c := img.Get(r.Min.X+x, y)
+			row[x] = RGBAF32{R: t.Decode8(c.R), G: t.Decode8(c.G), B: t.Decode8(c.B), A: float32(c.A) / 0xff}
+		}
+	}
+	return dst
+}
+
+// Returns the linear-light image encoded with the given transfer function.
+// It reverses [Linearize], rounding each channel to the nearest 8-bit value.
+// Values outside of [0, 1] are clamped.
+func Delinearize(img Image[RGBAF32], t *TransferFunction) *Grid[RGBA32] {
+	r := img.Bounds()
+	dst := NewImage[RGBA32](r)
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		row := dst.Pix[dst.PixOffset(r.Min.X, y):][:r.Dx()]
+		for x := range row {
+			c := img.Get(r.Min.X+x, y)
+			row[x] = RGBA32{R: t.Encode8(c.R), G: t.Encode8(c.G), B: t.Encode8(c.B), A: uint8(quantize(wideChannel(float64(c.A)), 0xff))}
+		}
+	}
+	return dst
+}
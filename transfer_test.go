@@ -0,0 +1,183 @@
+package pxl_test
+
+import (
+	"fmt"
+	"image"
+	"math"
+	"pxl"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransferFunction(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name string
+		t    *pxl.TransferFunction
+	}{{name: "sRGB", t: pxl.SRGB},
+		{name: "Rec709", t: pxl.Rec709},
+		{name: "Gamma2.2", t: pxl.Gamma(2.2)},
+		{name: "Gamma1.8", t: pxl.Gamma(1.8)}}
+	t.Run("Encode() and Decode()", func(t *testing.T) {
+		t.Run("return the expected values", func(t *testing.T) {
+			testCases := []struct {
+				name string
+				t    *pxl.TransferFunction
+				v    float64
+				e    float64
+			}{{name: "sRGB", t: pxl.SRGB, v: 0.5, e: 0.735357},
+				{name: "sRGB", t: pxl.SRGB, v: 0.002, e: 0.02584},
+				{name: "Rec709", t: pxl.Rec709, v: 0.5, e: 0.705436},
+				{name: "Rec709", t: pxl.Rec709, v: 0.01, e: 0.045},
+				{name: "Gamma2.2", t: pxl.Gamma(2.2), v: 0.5, e: 0.729740},
+				{name: "Gamma2.2", t: pxl.Gamma(2.2), v: -0.5, e: -0.729740}}
+			for _, testCase := range testCases {
+				t.Run(fmt.Sprintf("%s/%v", testCase.name, testCase.v), func(t *testing.T) {
+					assert.InDelta(t, testCase.e, testCase.t.Encode(testCase.v), 1e-6)
+					assert.InDelta(t, testCase.v, testCase.t.Decode(testCase.e), 1e-6)
+				})
+			}
+		})
+		t.Run("map black and white to themselves", func(t *testing.T) {
+			for _, testCase := range testCases {
+				t.Run(testCase.name, func(t *testing.T) {
+					assert.InDelta(t, 0, testCase.t.Encode(0), 1e-12)
+					assert.InDelta(t, 1, testCase.t.Encode(1), 1e-12)
+					assert.InDelta(t, 0, testCase.t.Decode(0), 1e-12)
+					assert.InDelta(t, 1, testCase.t.Decode(1), 1e-12)
+				})
+			}
+		})
+	})
+	t.Run("Decode8() and Decode16() match Decode()", func(t *testing.T) {
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				for v := 0; v <= 0xff; v++ {
+					assert.InDelta(t, testCase.t.Decode(float64(v)/0xff), testCase.t.Decode8(uint8(v)), 1e-7)
+				}
+				for v := 0; v <= 0xffff; v += 0x0101 {
+					assert.Equal(t, testCase.t.Decode8(uint8(v>>8)), testCase.t.Decode16(uint16(v)))
+				}
+			})
+		}
+	})
+	t.Run("Encode8() and Encode16()", func(t *testing.T) {
+		t.Run("round Encode() to the nearest value", func(t *testing.T) {
+			for _, testCase := range testCases {
+				t.Run(testCase.name, func(t *testing.T) {
+					for i := 0; i <= 1000; i++ {
+						v := math.Pow(float64(i)/1000, 3)
+						assert.Equal(t, uint8(math.Round(testCase.t.Encode(v)*0xff)), testCase.t.Encode8(float32(v)), v)
+						assert.InDelta(t, math.Round(testCase.t.Encode(v)*0xffff), testCase.t.Encode16(float32(v)), 1, v)
+					}
+				})
+			}
+		})
+		t.Run("invert Decode8() and Decode16()", func(t *testing.T) {
+			for _, testCase := range testCases {
+				t.Run(testCase.name, func(t *testing.T) {
+					for v := 0; v <= 0xff; v++ {
+						assert.Equal(t, uint8(v), testCase.t.Encode8(testCase.t.Decode8(uint8(v))))
+					}
+					for v := 0; v <= 0xffff; v += 0xff {
+						assert.Equal(t, uint16(v), testCase.t.Encode16(testCase.t.Decode16(uint16(v))))
+					}
+				})
+			}
+		})
+		t.Run("clamp values outside of [0, 1]", func(t *testing.T) {
+			assert.Equal(t, uint8(0x00), pxl.SRGB.Encode8(-1))
+			assert.Equal(t, uint8(0xff), pxl.SRGB.Encode8(4))
+			assert.Equal(t, uint8(0x00), pxl.SRGB.Encode8(float32(math.NaN())))
+			assert.Equal(t, uint16(0xffff), pxl.Rec709.Encode16(float32(math.Inf(1))))
+		})
+	})
+}
+
+func TestLinearize(t *testing.T) {
+	t.Parallel()
+	img := pxl.NewImage[pxl.RGBA32](image.Rect(-1, 2, 15, 18))
+	for i := range img.Pix {
+		img.Pix[i] = pxl.RGBA32{R: uint8(i), G: uint8(i * 7), B: uint8(255 - i), A: uint8(i * 3)}
+	}
+	t.Run("matches Convert() for sRGB", func(t *testing.T) {
+		lin := pxl.Linearize(img, pxl.SRGB)
+		assert.Equal(t, img.Bounds(), lin.Bounds())
+		for _, p := range []image.Point{{-1, 2}, {3, 5}, {14, 17}} {
+			c := pxl.Convert[pxl.RGBAF32](img.Get(p.X, p.Y))
+			l := lin.Get(p.X, p.Y)
+			assert.InDelta(t, c.R, l.R, 1e-6, p)
+			assert.InDelta(t, c.G, l.G, 1e-6, p)
+			assert.InDelta(t, c.B, l.B, 1e-6, p)
+			assert.InDelta(t, c.A, l.A, 1e-6, p)
+		}
+	})
+	t.Run("is reversed by Delinearize()", func(t *testing.T) {
+		for _, tf := range []*pxl.TransferFunction{pxl.SRGB, pxl.Rec709, pxl.Gamma(2.2)} {
+			assert.Equal(t, img, pxl.Delinearize(pxl.Linearize(img, tf), tf))
+		}
+	})
+	t.Run("reads any image", func(t *testing.T) {
+		sub := img.SubImage(image.Rect(2, 4, 6, 9))
+		lin := pxl.Linearize(sub, pxl.SRGB)
+		assert.Equal(t, sub.Bounds(), lin.Bounds())
+		assert.Equal(t, pxl.SRGB.Decode8(sub.Get(3, 5).G), lin.Get(3, 5).G)
+		enc := pxl.Delinearize(lin, pxl.SRGB)
+		for y := 4; y < 9; y++ {
+			for x := 2; x < 6; x++ {
+				assert.Equal(t, sub.Get(x, y), enc.Get(x, y))
+			}
+		}
+	})
+	t.Run("averages in linear light", func(t *testing.T) {
+		src := pxl.NewImage[pxl.RGBA32](image.Rect(0, 0, 2, 1))
+		src.Set(0, 0, pxl.RGBA32{A: 0xff})
+		src.Set(1, 0, pxl.RGBA32{R: 0xff, G: 0xff, B: 0xff, A: 0xff})
+		lin := pxl.Linearize(src, pxl.SRGB)
+		a, b := lin.Get(0, 0), lin.Get(1, 0)
+		lin.Set(0, 0, pxl.RGBAF32{R: (a.R + b.R) / 2, G: (a.G + b.G) / 2, B: (a.B + b.B) / 2, A: (a.A + b.A) / 2})
+		assert.Equal(t, pxl.RGBA32{R: 0xbc, G: 0xbc, B: 0xbc, A: 0xff}, pxl.Delinearize(lin, pxl.SRGB).Get(0, 0))
+	})
+}
+
+func BenchmarkTransferFunction(b *testing.B) {
+	b.Run("Decode8", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = pxl.SRGB.Decode8(uint8(i))
+		}
+	})
+	b.Run("Encode8", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = pxl.SRGB.Encode8(float32(i&0xff) / 0xff)
+		}
+	})
+	b.Run("Encode16", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = pxl.SRGB.Encode16(float32(i&0xffff) / 0xffff)
+		}
+	})
+	b.Run("Encode", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = pxl.SRGB.Encode(float64(i&0xff) / 0xff)
+		}
+	})
+}
+
+func BenchmarkLinearize(b *testing.B) {
+	img := pxl.NewImage[pxl.RGBA32](image.Rect(0, 0, 256, 256))
+	for i := range img.Pix {
+		img.Pix[i] = pxl.RGBA32{R: uint8(i), G: uint8(i >> 8), B: 0x80, A: 0xff}
+	}
+	lin := pxl.Linearize(img, pxl.SRGB)
+	b.Run("Linearize", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = pxl.Linearize(img, pxl.SRGB)
+		}
+	})
+	b.Run("Delinearize", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = pxl.Delinearize(lin, pxl.SRGB)
+		}
+	})
+}
@@ -0,0 +1,295 @@
+package pxl
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"strconv"
+)
+
+// A YCbCrMatrix is a set of luma coefficients used to separate luma from chroma.
+type YCbCrMatrix uint8
+
+// Luma coefficients defined by the ITU-R recommendations.
+const (
+	// BT601 is the matrix of standard-definition video and of JPEG (ITU-R BT.601).
+	BT601 YCbCrMatrix = iota
+	// BT709 is the matrix of high-definition video (ITU-R BT.709).
+	BT709
+	// BT2020 is the non-constant luminance matrix of ultra-high-definition video (ITU-R BT.2020).
+	BT2020
+)
+
+// Returns the name of the matrix.
+func (m YCbCrMatrix) String() string {
+	switch m {
+	case BT601:
+		return "BT.601"
+	case BT709:
+		return "BT.709"
+	case BT2020:
+		return "BT.2020"
+	}
+	return "YCbCrMatrix(" + strconv.Itoa(int(m)) + ")"
+}
+
+// Returns the red and blue luma coefficients of the matrix.
+// The green coefficient is the remainder of their sum to 1.
+func (m YCbCrMatrix) coefficients() (kr, kb float64) {
+	switch m {
+	case BT709:
+		return 0.2126, 0.0722
+	case BT2020:
+		return 0.2627, 0.0593
+	}
+	return 0.299, 0.114
+}
+
+// A YCbCrRange is the range of code values used by the samples of a YCbCr color.
+type YCbCrRange uint8
+
+// Ranges of code values.
+const (
+	// FullRange uses every code value, as JPEG does.
+	FullRange YCbCrRange = iota
+	// LimitedRange keeps luma within [16, 235] and chroma within [16, 240], as broadcast video does.
+	LimitedRange
+)
+
+// Returns the name of the range.
+func (r YCbCrRange) String() string {
+	switch r {
+	case FullRange:
+		return "Full"
+	case LimitedRange:
+		return "Limited"
+	}
+	return "YCbCrRange(" + strconv.Itoa(int(r)) + ")"
+}
+
+// A YCbCrEncoding describes how the samples of a YCbCr color encode an sRGB color.
+// The zero value is the full-range BT.601 encoding of JPEG, used by the standard library's [image/color.YCbCr].
+type YCbCrEncoding struct {
+	Matrix YCbCrMatrix
+	Range  YCbCrRange
+}
+
+// Returns the YCbCr color encoding the given color.
+// Colors that are not opaque are composited over black.
+func (e YCbCrEncoding) Convert(c color.Color) YCbCr {
+	if v, ok := c.(YCbCr); ok && v.Encoding == e {
+		return v
+	}
+	r, g, b, a := wideOf(c).floats()
+	return e.encode(r*a, g*a, b*a)
+}
+
+// Returns the color model that converts colors into YCbCr colors of the encoding.
+func (e YCbCrEncoding) Model() color.Model {
+	return color.ModelFunc(func(c color.Color) color.Color {
+		return e.Convert(c)
+	})
+}
+
+// Returns the YCbCr color encoding the given sRGB channels, each within [0, 1].
+func (e YCbCrEncoding) encode(r, g, b float64) YCbCr {
+	kr, kb := e.Matrix.coefficients()
+	y := kr*r + (1-kr-kb)*g + kb*b
+	cb, cr := (b-y)/(2*(1-kb)), (r-y)/(2*(1-kr))
+	ys, cs, off := 255.0, 255.0, 0.0
+	if e.Range == LimitedRange {
+		ys, cs, off = 219, 224, 16
+	}
+	return YCbCr{Y: sample(off + ys*y), Cb: sample(128 + cs*cb), Cr: sample(128 + cs*cr), Encoding: e}
+}
+
+// Returns the sRGB channels, each within [0, 1], encoded by the given samples.
+func (e YCbCrEncoding) decode(y, cb, cr uint8) (r, g, b float64) {
+	ys, cs, off := 255.0, 255.0, 0.0
+	if e.Range == LimitedRange {
+		ys, cs, off = 219, 224, 16
+	}
+	kr, kb := e.Matrix.coefficients()
+	l := (float64(y) - off) / ys
+	pb, pr := (float64(cb)-128)/cs, (float64(cr)-128)/cs
+	r = l + 2*(1-kr)*pr
+	b = l + 2*(1-kb)*pb
+	g = (l - kr*r - kb*b) / (1 - kr - kb)
+	return r, g, b
+}
+
+// Rounds v to the nearest 8-bit sample, clamping it within [0, 0xff].
+func sample(v float64) uint8 {
+	return uint8(math.Round(min(max(v, 0), 0xff)))
+}
+
+// A YCbCr is an opaque color represented by a luma (Y) and two chroma (Cb, Cr) samples of 8 bits each,
+// as used by JPEG and digital video, where it is commonly called YUV.
+// The encoding defines how the samples relate to sRGB.
+// Converting into YCbCr uses the zero-value encoding; see [YCbCrEncoding.Convert] for the others.
+type YCbCr struct {
+	Y, Cb, Cr uint8
+	Encoding  YCbCrEncoding
+}
+
+// Returns the alpha-premultiplied red, green, blue and alpha values
+// for the color. Each value ranges within [0, 0xffff], but is represented
+// by a uint32 so that multiplying by a blend factor up to 0xffff will not
+// overflow.
+//
+// An alpha-premultiplied color component c has been scaled by alpha (a),
+// so has valid values 0 <= c <= a.
+func (c YCbCr) RGBA() (r, g, b, a uint32) {
+	return wideOf(c).rgba()
+}
+
+// Returns the hexadecimal code representing the RGBA color,
+// with 16 bits per channel.
+func (c YCbCr) Hex() string {
+	return convert[RGBA64](c).Hex()
+}
+
+// A YCbCrImage is an in-memory image of [YCbCr] colors stored in planes, one per sample.
+// Depending on its subsample ratio, each chroma sample may be shared by several pixels:
+// 4:4:4 stores one chroma sample per pixel, 4:2:2 one per two horizontally adjacent pixels,
+// and 4:2:0 one per 2x2 block of pixels.
+// Its planes are laid out as those of the standard library's [image.YCbCr].
+type YCbCrImage struct {
+	// Y, Cb and Cr hold the image's samples.
+	Y, Cb, Cr []uint8
+	// YStride is the Y stride (in bytes) between vertically adjacent pixels.
+	YStride int
+	// CStride is the Cb and Cr stride (in bytes) between vertically adjacent chroma samples.
+	CStride int
+	// SubsampleRatio is the ratio of chroma samples to pixels.
+	SubsampleRatio image.YCbCrSubsampleRatio
+	// Rect is the image's bounds.
+	Rect image.Rectangle
+	// Encoding is the encoding of the image's colors.
+	Encoding YCbCrEncoding
+}
+
+// Returns a new YCbCrImage with the given bounds, subsample ratio and encoding.
+// Every sample is initialized to zero.
+func NewYCbCrImage(r image.Rectangle, ratio image.YCbCrSubsampleRatio, e YCbCrEncoding) *YCbCrImage {
+	img := FromYCbCr(image.NewYCbCr(r, ratio))
+	img.Encoding = e
+	return img
+}
+
+// Returns the standard library [image.YCbCr], such as a decoded JPEG, as a YCbCrImage that shares its planes.
+func FromYCbCr(img *image.YCbCr) *YCbCrImage {
+	return &YCbCrImage{
+		Y:              img.Y,
+		Cb:             img.Cb,
+		Cr:             img.Cr,
+		YStride:        img.YStride,
+		CStride:        img.CStride,
+		SubsampleRatio: img.SubsampleRatio,
+		Rect:           img.Rect,
+	}
+}
+
+// Returns the image as a standard library [image.YCbCr], which always uses the zero-value encoding.
+// An image of that encoding shares its planes with the returned image; any other image is re-encoded into new planes.
+func ToYCbCr(img *YCbCrImage) *image.YCbCr {
+	if img.Encoding == (YCbCrEncoding{}) {
+		return &image.YCbCr{
+			Y:              img.Y,
+			Cb:             img.Cb,
+			Cr:             img.Cr,
+			YStride:        img.YStride,
+			CStride:        img.CStride,
+			SubsampleRatio: img.SubsampleRatio,
+			Rect:           img.Rect,
+		}
+	}
+	dst := NewYCbCrImage(img.Rect, img.SubsampleRatio, YCbCrEncoding{})
+	for y := img.Rect.Min.Y; y < img.Rect.Max.Y; y++ {
+		for x := img.Rect.Min.X; x < img.Rect.Max.X; x++ {
+			dst.Set(x, y, img.Get(x, y))
+		}
+	}
+	return ToYCbCr(dst)
+}
+
+// Returns the domain for which At can return non-zero color.
+func (p *YCbCrImage) Bounds() image.Rectangle {
+	return p.Rect
+}
+
+// Returns the color model of the image.
+func (p *YCbCrImage) ColorModel() color.Model {
+	return p.Encoding.Model()
+}
+
+// Returns the color of the pixel at (x, y).
+// Pixels outside of the image's bounds are the zero value of YCbCr.
+func (p *YCbCrImage) At(x, y int) color.Color {
+	return p.Get(x, y)
+}
+
+// Returns the color of the pixel at (x, y), reconstructed from its luma sample and the chroma samples it shares.
+// Pixels outside of the image's bounds are the zero value of YCbCr.
+func (p *YCbCrImage) Get(x, y int) YCbCr {
+	if !(image.Point{x, y}.In(p.Rect)) {
+		return YCbCr{}
+	}
+	yi, ci := p.YOffset(x, y), p.COffset(x, y)
+	return YCbCr{Y: p.Y[yi], Cb: p.Cb[ci], Cr: p.Cr[ci], Encoding: p.Encoding}
+}
+
+// Sets the color of the pixel at (x, y), after converting it into the image's encoding.
+// The chroma samples are shared with the other pixels of the subsampled block, so that setting a pixel
+// also sets the chroma of its neighbors.
+// Pixels outside of the image's bounds are left untouched.
+func (p *YCbCrImage) Set(x, y int, c YCbCr) {
+	if !(image.Point{x, y}.In(p.Rect)) {
+		return
+	}
+	c = p.Encoding.Convert(c)
+	yi, ci := p.YOffset(x, y), p.COffset(x, y)
+	p.Y[yi], p.Cb[ci], p.Cr[ci] = c.Y, c.Cb, c.Cr
+}
+
+// Returns the index of the byte of Y that corresponds to the pixel at (x, y).
+func (p *YCbCrImage) YOffset(x, y int) int {
+	return (y-p.Rect.Min.Y)*p.YStride + (x - p.Rect.Min.X)
+}
+
+// Returns the index of the bytes of Cb and Cr that correspond to the pixel at (x, y).
+func (p *YCbCrImage) COffset(x, y int) int {
+	switch p.SubsampleRatio {
+	case image.YCbCrSubsampleRatio422:
+		return (y-p.Rect.Min.Y)*p.CStride + (x/2 - p.Rect.Min.X/2)
+	case image.YCbCrSubsampleRatio420:
+		return (y/2-p.Rect.Min.Y/2)*p.CStride + (x/2 - p.Rect.Min.X/2)
+	case image.YCbCrSubsampleRatio440:
+		return (y/2-p.Rect.Min.Y/2)*p.CStride + (x - p.Rect.Min.X)
+	case image.YCbCrSubsampleRatio411:
+		return (y-p.Rect.Min.Y)*p.CStride + (x/4 - p.Rect.Min.X/4)
+	case image.YCbCrSubsampleRatio410:
+		return (y/2-p.Rect.Min.Y/2)*p.CStride + (x/4 - p.Rect.Min.X/4)
+	}
+	return (y-p.Rect.Min.Y)*p.CStride + (x - p.Rect.Min.X)
+}
+
+// Returns an image representing the portion of the image visible through r.
+// The returned image shares samples with the original image.
+func (p *YCbCrImage) SubImage(r image.Rectangle) Image[YCbCr] {
+	r = r.Intersect(p.Rect)
+	if r.Empty() {
+		return &YCbCrImage{SubsampleRatio: p.SubsampleRatio, Encoding: p.Encoding}
+	}
+	yi, ci := p.YOffset(r.Min.X, r.Min.Y), p.COffset(r.Min.X, r.Min.Y)
+	return &YCbCrImage{
+		Y:              p.Y[yi:],
+		Cb:             p.Cb[ci:],
+		Cr:             p.Cr[ci:],
+		YStride:        p.YStride,
+		CStride:        p.CStride,
+		SubsampleRatio: p.SubsampleRatio,
+		Rect:           r,
+		Encoding:       p.Encoding,
+	}
+}
@@ -0,0 +1,230 @@
+package pxl_test
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"pxl"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestYCbCr(t *testing.T) {
+	t.Parallel()
+	t.Run("implements the pxl color interface", func(t *testing.T) {
+		var v any = pxl.YCbCr{}
+		_, ok := v.(pxl.Color)
+		assert.True(t, ok)
+	})
+	t.Run("RGBA()", func(t *testing.T) {
+		t.Run("matches the standard library up to rounding", func(t *testing.T) {
+			testCases := []pxl.YCbCr{{Y: 0x00, Cb: 0x80, Cr: 0x80},
+				{Y: 0xff, Cb: 0x80, Cr: 0x80},
+				{Y: 0x51, Cb: 0x5a, Cr: 0xf0},
+				{Y: 0x9b, Cb: 0x20, Cr: 0xc0},
+				{Y: 0x10, Cb: 0xff, Cr: 0x00}}
+			for _, testCase := range testCases {
+				t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+					r, g, b, a := testCase.RGBA()
+					er, eg, eb, ea := color.YCbCr{Y: testCase.Y, Cb: testCase.Cb, Cr: testCase.Cr}.RGBA()
+					assert.InDelta(t, er, r, 0x101)
+					assert.InDelta(t, eg, g, 0x101)
+					assert.InDelta(t, eb, b, 0x101)
+					assert.Equal(t, ea, a)
+				})
+			}
+		})
+	})
+	t.Run("Hex()", func(t *testing.T) {
+		assert.Equal(t, "ffffffffffffffff", pxl.YCbCr{Y: 0xff, Cb: 0x80, Cr: 0x80}.Hex())
+		assert.Equal(t, "ffffffffffffffff", pxl.YCbCr{Y: 0xeb, Cb: 0x80, Cr: 0x80, Encoding: pxl.YCbCrEncoding{Matrix: pxl.BT709, Range: pxl.LimitedRange}}.Hex())
+	})
+	t.Run("Convert()", func(t *testing.T) {
+		t.Run("matches the standard library up to rounding", func(t *testing.T) {
+			testCases := []pxl.RGBA32{{A: 0xff},
+				{R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+				{R: 0xff, A: 0xff},
+				{R: 0x66, G: 0x33, B: 0x99, A: 0xff},
+				{R: 0xaa, G: 0x55, B: 0x11, A: 0xff}}
+			for _, testCase := range testCases {
+				t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+					c := pxl.Convert[pxl.YCbCr](testCase)
+					ey, ecb, ecr := color.RGBToYCbCr(testCase.R, testCase.G, testCase.B)
+					assert.InDelta(t, ey, c.Y, 1)
+					assert.InDelta(t, ecb, c.Cb, 1)
+					assert.InDelta(t, ecr, c.Cr, 1)
+					assert.Equal(t, pxl.YCbCrEncoding{}, c.Encoding)
+				})
+			}
+		})
+	})
+}
+
+func TestYCbCrEncoding(t *testing.T) {
+	t.Parallel()
+	bt601 := pxl.YCbCrEncoding{Matrix: pxl.BT601, Range: pxl.LimitedRange}
+	bt709 := pxl.YCbCrEncoding{Matrix: pxl.BT709, Range: pxl.LimitedRange}
+	bt2020 := pxl.YCbCrEncoding{Matrix: pxl.BT2020}
+	t.Run("Convert()", func(t *testing.T) {
+		t.Run("returns the expected samples", func(t *testing.T) {
+			red := pxl.RGBA32{R: 0xff, A: 0xff}
+			white := pxl.RGBA32{R: 0xff, G: 0xff, B: 0xff, A: 0xff}
+			testCases := []struct {
+				e pxl.YCbCrEncoding
+				c pxl.RGBA32
+				y uint8
+				b uint8
+				r uint8
+			}{{e: bt601, c: red, y: 81, b: 90, r: 240},
+				{e: bt709, c: red, y: 63, b: 102, r: 240},
+				{e: bt2020, c: red, y: 67, b: 92, r: 255},
+				{e: bt709, c: white, y: 235, b: 128, r: 128},
+				{e: bt709, c: pxl.RGBA32{A: 0xff}, y: 16, b: 128, r: 128},
+				{e: bt709, c: pxl.RGBA32{R: 0xff, G: 0xff, B: 0xff}, y: 16, b: 128, r: 128}}
+			for _, testCase := range testCases {
+				t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+					assert.Equal(t, pxl.YCbCr{Y: testCase.y, Cb: testCase.b, Cr: testCase.r, Encoding: testCase.e}, testCase.e.Convert(testCase.c))
+				})
+			}
+		})
+		t.Run("round-trips colors through every encoding", func(t *testing.T) {
+			c := pxl.RGBA32{R: 0xaa, G: 0x55, B: 0x33, A: 0xff}
+			for _, e := range []pxl.YCbCrEncoding{{}, bt601, bt709, bt2020, {Matrix: pxl.BT709}, {Matrix: pxl.BT2020, Range: pxl.LimitedRange}} {
+				t.Run(fmt.Sprintf("%+v", e), func(t *testing.T) {
+					assert.Less(t, pxl.DeltaE2000(c, e.Convert(c)), 1.0)
+				})
+			}
+		})
+		t.Run("re-encodes YCbCr colors of other encodings", func(t *testing.T) {
+			c := bt709.Convert(pxl.RGBA32{R: 0xaa, G: 0x55, B: 0x33, A: 0xff})
+			assert.Equal(t, c, bt709.Convert(c))
+			assert.Equal(t, c, pxl.Convert[pxl.YCbCr](c))
+			assert.Equal(t, pxl.Convert[pxl.YCbCr](pxl.Convert[pxl.RGBA64](c)), pxl.YCbCrEncoding{}.Convert(c))
+		})
+	})
+	t.Run("Model() converts into the encoding", func(t *testing.T) {
+		assert.Equal(t, bt709.Convert(pxl.RGBA32{R: 0xff, A: 0xff}), bt709.Model().Convert(color.NRGBA{R: 0xff, A: 0xff}))
+	})
+	t.Run("String()", func(t *testing.T) {
+		assert.Equal(t, "BT.2020", pxl.BT2020.String())
+		assert.Equal(t, "YCbCrMatrix(7)", pxl.YCbCrMatrix(7).String())
+		assert.Equal(t, "Limited", pxl.LimitedRange.String())
+		assert.Equal(t, "YCbCrRange(2)", pxl.YCbCrRange(2).String())
+	})
+}
+
+func TestYCbCrImage(t *testing.T) {
+	t.Parallel()
+	bt709 := pxl.YCbCrEncoding{Matrix: pxl.BT709, Range: pxl.LimitedRange}
+	t.Run("implements the pxl image interface", func(t *testing.T) {
+		var v any = pxl.NewYCbCrImage(image.Rect(0, 0, 1, 1), image.YCbCrSubsampleRatio420, bt709)
+		_, ok := v.(pxl.Image[pxl.YCbCr])
+		assert.True(t, ok)
+	})
+	t.Run("NewYCbCrImage() allocates subsampled planes", func(t *testing.T) {
+		testCases := []struct {
+			ratio image.YCbCrSubsampleRatio
+			y     int
+			c     int
+		}{{ratio: image.YCbCrSubsampleRatio444, y: 24, c: 24},
+			{ratio: image.YCbCrSubsampleRatio422, y: 24, c: 12},
+			{ratio: image.YCbCrSubsampleRatio420, y: 24, c: 6}}
+		for _, testCase := range testCases {
+			t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+				img := pxl.NewYCbCrImage(image.Rect(0, 0, 6, 4), testCase.ratio, bt709)
+				assert.Len(t, img.Y, testCase.y)
+				assert.Len(t, img.Cb, testCase.c)
+				assert.Len(t, img.Cr, testCase.c)
+			})
+		}
+	})
+	t.Run("Get()", func(t *testing.T) {
+		t.Run("reconstructs pixels from shared chroma samples", func(t *testing.T) {
+			testCases := []struct {
+				ratio  image.YCbCrSubsampleRatio
+				shared []image.Point
+				own    []image.Point
+			}{{ratio: image.YCbCrSubsampleRatio444, own: []image.Point{{3, 2}, {2, 3}, {3, 3}}},
+				{ratio: image.YCbCrSubsampleRatio422, shared: []image.Point{{3, 2}}, own: []image.Point{{2, 3}, {3, 3}}},
+				{ratio: image.YCbCrSubsampleRatio420, shared: []image.Point{{3, 2}, {2, 3}, {3, 3}}, own: []image.Point{{1, 2}, {2, 1}}}}
+			for _, testCase := range testCases {
+				t.Run(fmt.Sprintf("%+v", testCase), func(t *testing.T) {
+					img := pxl.NewYCbCrImage(image.Rect(0, 0, 4, 4), testCase.ratio, bt709)
+					c := bt709.Convert(pxl.RGBA32{R: 0xaa, G: 0x55, B: 0x33, A: 0xff})
+					img.Set(2, 2, c)
+					assert.Equal(t, c, img.Get(2, 2))
+					assert.Equal(t, c, img.At(2, 2))
+					for _, p := range testCase.shared {
+						assert.Equal(t, pxl.YCbCr{Cb: c.Cb, Cr: c.Cr, Encoding: bt709}, img.Get(p.X, p.Y), p)
+					}
+					for _, p := range testCase.own {
+						assert.Equal(t, pxl.YCbCr{Encoding: bt709}, img.Get(p.X, p.Y), p)
+					}
+				})
+			}
+		})
+		t.Run("returns the zero value out of bounds", func(t *testing.T) {
+			img := pxl.NewYCbCrImage(image.Rect(0, 0, 2, 2), image.YCbCrSubsampleRatio420, bt709)
+			img.Set(2, 2, pxl.YCbCr{Y: 0xff})
+			assert.Equal(t, pxl.YCbCr{}, img.Get(2, 2))
+		})
+	})
+	t.Run("Set() converts colors into the image's encoding", func(t *testing.T) {
+		img := pxl.NewYCbCrImage(image.Rect(0, 0, 2, 2), image.YCbCrSubsampleRatio444, bt709)
+		img.Set(0, 0, pxl.YCbCr{Y: 0xff, Cb: 0x80, Cr: 0x80})
+		assert.Equal(t, pxl.YCbCr{Y: 0xeb, Cb: 0x80, Cr: 0x80, Encoding: bt709}, img.Get(0, 0))
+	})
+	t.Run("SubImage() shares samples with the original image", func(t *testing.T) {
+		img := pxl.NewYCbCrImage(image.Rect(0, 0, 6, 4), image.YCbCrSubsampleRatio420, bt709)
+		sub := img.SubImage(image.Rect(3, 1, 8, 3))
+		assert.Equal(t, image.Rect(3, 1, 6, 3), sub.Bounds())
+		c := bt709.Convert(pxl.RGBA32{R: 0xaa, G: 0x55, B: 0x33, A: 0xff})
+		sub.Set(3, 1, c)
+		assert.Equal(t, c, img.Get(3, 1))
+		assert.Equal(t, c.Cr, img.Get(2, 0).Cr)
+		img.Set(5, 2, c)
+		assert.Equal(t, c, sub.Get(5, 2))
+		assert.Equal(t, c.Cb, sub.Get(4, 2).Cb)
+		assert.Equal(t, image.Rectangle{}, img.SubImage(image.Rect(7, 7, 9, 9)).Bounds())
+	})
+	t.Run("FromYCbCr() shares the planes of the image", func(t *testing.T) {
+		std := image.NewYCbCr(image.Rect(0, 0, 4, 4), image.YCbCrSubsampleRatio422)
+		img := pxl.FromYCbCr(std)
+		c := pxl.YCbCr{Y: 0x51, Cb: 0x5a, Cr: 0xf0}
+		img.Set(1, 2, c)
+		assert.Equal(t, color.YCbCr{Y: 0x51, Cb: 0x5a, Cr: 0xf0}, std.At(1, 2))
+		assert.Equal(t, pxl.YCbCr{Cb: 0x5a, Cr: 0xf0}, img.Get(0, 2))
+	})
+	t.Run("ToYCbCr()", func(t *testing.T) {
+		t.Run("shares the planes of images of the zero-value encoding", func(t *testing.T) {
+			img := pxl.NewYCbCrImage(image.Rect(0, 0, 4, 4), image.YCbCrSubsampleRatio420, pxl.YCbCrEncoding{})
+			std := pxl.ToYCbCr(img)
+			std.Y[std.YOffset(3, 3)] = 0x9b
+			assert.Equal(t, uint8(0x9b), img.Get(3, 3).Y)
+		})
+		t.Run("re-encodes images of other encodings", func(t *testing.T) {
+			img := pxl.NewYCbCrImage(image.Rect(0, 0, 4, 4), image.YCbCrSubsampleRatio420, bt709)
+			img.Set(2, 2, pxl.YCbCr{Y: 0xeb, Cb: 0x80, Cr: 0x80, Encoding: bt709})
+			std := pxl.ToYCbCr(img)
+			assert.Equal(t, image.YCbCrSubsampleRatio420, std.SubsampleRatio)
+			assert.Equal(t, color.YCbCr{Y: 0xff, Cb: 0x80, Cr: 0x80}, std.At(2, 2))
+			assert.Equal(t, uint8(0xeb), img.Get(2, 2).Y)
+		})
+	})
+}
+
+func BenchmarkYCbCrImage(b *testing.B) {
+	img := pxl.NewYCbCrImage(image.Rect(0, 0, 64, 64), image.YCbCrSubsampleRatio420, pxl.YCbCrEncoding{Matrix: pxl.BT709, Range: pxl.LimitedRange})
+	b.Run("Get", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = img.Get(i%64, (i/64)%64)
+		}
+	})
+	b.Run("RGBA", func(b *testing.B) {
+		c := img.Get(1, 1)
+		for i := 0; i < b.N; i++ {
+			_, _, _, _ = c.RGBA()
+		}
+	})
+}